@@ -0,0 +1,137 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ctpcommonv1alpha1 "github.com/upbound/provider-upbound/apis/common/controlplane/v1alpha1"
+)
+
+// ConfigurationParameters are the configurable fields of a Configuration.
+type ConfigurationParameters struct {
+	// Name of this Configuration.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Name string `json:"name"`
+
+	// OrganizationName is the name of the organization to which the
+	// configuration belongs.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	OrganizationName string `json:"organizationName"`
+
+	// TemplateID is the ID of the template the Git repository of the
+	// configuration is created from. It is required to create a
+	// configuration and late-initialized when one is imported.
+	// +optional
+	// +immutable
+	TemplateID string `json:"templateId,omitempty"`
+
+	// Provider is the Git provider hosting the repository of the
+	// configuration.
+	// +kubebuilder:validation:Enum=github
+	// +kubebuilder:default=github
+	// +optional
+	// +immutable
+	Provider string `json:"provider,omitempty"`
+
+	// Context is the account or organization of the Git provider that owns the
+	// repository of the configuration. It is required to create a
+	// configuration and late-initialized when one is imported.
+	// +optional
+	// +immutable
+	Context string `json:"context,omitempty"`
+
+	// Repo is the name of the Git repository of the configuration. It is
+	// usually the same as the name of the configuration. It is required to
+	// create a configuration and late-initialized when one is imported.
+	// +optional
+	// +immutable
+	Repo string `json:"repo,omitempty"`
+
+	// Description of the configuration. It is late-initialized when unset.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Private creates a private Git repository.
+	// +optional
+	// +immutable
+	Private bool `json:"private,omitempty"`
+}
+
+// ConfigurationObservation are the observable fields of a Configuration.
+type ConfigurationObservation struct {
+	ctpcommonv1alpha1.ConfigurationObservation `json:",inline"`
+}
+
+// A ConfigurationSpec defines the desired state of a Configuration.
+type ConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigurationParameters `json:"forProvider"`
+}
+
+// A ConfigurationStatus represents the observed state of a Configuration.
+type ConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Configuration is an Upbound Cloud configuration that can be installed in
+// a ControlPlane. It is ready once a version of it has been built.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.atProvider.latestVersion"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,upbound}
+type Configuration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurationSpec   `json:"spec"`
+	Status ConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigurationList contains a list of Configuration
+type ConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Configuration `json:"items"`
+}
+
+// Configuration type metadata.
+var (
+	ConfigurationKind             = reflect.TypeOf(Configuration{}).Name()
+	ConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigurationKind}.String()
+	ConfigurationKindAPIVersion   = ConfigurationKind + "." + SchemeGroupVersion.String()
+	ConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(ConfigurationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Configuration{}, &ConfigurationList{})
+}
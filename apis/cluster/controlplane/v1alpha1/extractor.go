@@ -0,0 +1,46 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+)
+
+// ControlPlaneID extracts the ID of a ControlPlane, which differs from its
+// external name.
+func ControlPlaneID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cp, ok := mg.(*ControlPlane)
+		if !ok {
+			return ""
+		}
+		return cp.Status.AtProvider.ID
+	}
+}
+
+// ConfigurationID extracts the ID of a Configuration, which differs from its
+// external name.
+func ConfigurationID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*Configuration)
+		if !ok {
+			return ""
+		}
+		return c.Status.AtProvider.ID
+	}
+}
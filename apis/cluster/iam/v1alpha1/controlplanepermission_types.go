@@ -0,0 +1,132 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// ControlPlanePermissionParameters are the configurable fields of a
+// ControlPlanePermission.
+type ControlPlanePermissionParameters struct {
+	// OrganizationName is the name of the organization to which the control
+	// plane belongs.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	OrganizationName string `json:"organizationName"`
+
+	// Permission is the permission to grant to the team on the control plane.
+	// It is required to grant a permission and late-initialized when one is
+	// imported.
+	// +kubebuilder:validation:Enum=viewer;editor;owner
+	// +optional
+	Permission string `json:"permission,omitempty"`
+
+	// TeamID of the team to grant the permission to. Either teamId or
+	// teamIdRef or teamIdSelector is required.
+	// +crossplane:generate:reference:type=Team
+	// +immutable
+	TeamID *string `json:"teamId,omitempty"`
+
+	// TeamIDRef references a Team to and retrieves its teamId.
+	TeamIDRef *xpv1.Reference `json:"teamIdRef,omitempty"`
+
+	// TeamIDSelector selects a reference to a Team in order to retrieve its
+	// teamId.
+	TeamIDSelector *xpv1.Selector `json:"teamIdSelector,omitempty"`
+
+	// ControlPlane is the name of the control plane to grant the permission
+	// on. Either controlPlane or controlPlaneRef or controlPlaneSelector is
+	// required.
+	// +crossplane:generate:reference:type=github.com/upbound/provider-upbound/apis/cluster/controlplane/v1alpha1.ControlPlane
+	// +immutable
+	ControlPlane *string `json:"controlPlane,omitempty"`
+
+	// ControlPlaneRef references a ControlPlane to and retrieves its name.
+	ControlPlaneRef *xpv1.Reference `json:"controlPlaneRef,omitempty"`
+
+	// ControlPlaneSelector selects a reference to a ControlPlane in order to
+	// retrieve its name.
+	ControlPlaneSelector *xpv1.Selector `json:"controlPlaneSelector,omitempty"`
+}
+
+// ControlPlanePermissionObservation are the observable fields of a
+// ControlPlanePermission.
+type ControlPlanePermissionObservation struct {
+	// Permission is the permission the team currently has on the control
+	// plane.
+	Permission string `json:"permission,omitempty"`
+}
+
+// A ControlPlanePermissionSpec defines the desired state of a
+// ControlPlanePermission.
+type ControlPlanePermissionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ControlPlanePermissionParameters `json:"forProvider"`
+}
+
+// A ControlPlanePermissionStatus represents the observed state of a
+// ControlPlanePermission.
+type ControlPlanePermissionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ControlPlanePermissionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ControlPlanePermission grants a team a permission on a control plane.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="PERMISSION",type="string",JSONPath=".status.atProvider.permission"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,upbound}
+type ControlPlanePermission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ControlPlanePermissionSpec   `json:"spec"`
+	Status ControlPlanePermissionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ControlPlanePermissionList contains a list of ControlPlanePermission
+type ControlPlanePermissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ControlPlanePermission `json:"items"`
+}
+
+// ControlPlanePermission type metadata.
+var (
+	ControlPlanePermissionKind             = reflect.TypeOf(ControlPlanePermission{}).Name()
+	ControlPlanePermissionGroupKind        = schema.GroupKind{Group: Group, Kind: ControlPlanePermissionKind}.String()
+	ControlPlanePermissionKindAPIVersion   = ControlPlanePermissionKind + "." + SchemeGroupVersion.String()
+	ControlPlanePermissionGroupVersionKind = SchemeGroupVersion.WithKind(ControlPlanePermissionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ControlPlanePermission{}, &ControlPlanePermissionList{})
+}
@@ -18,30 +18,60 @@ package v1alpha1
 
 import (
 	"context"
+	"strconv"
+
+	"github.com/google/uuid"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	controlplanev1alpha1 "github.com/upbound/provider-upbound/apis/cluster/controlplane/v1alpha1"
+)
+
+// Token owner types.
+const (
+	OwnerTypeUsers         = "users"
+	OwnerTypeControlPlanes = "controlPlanes"
+	OwnerTypeRobots        = "robots"
 )
 
 func (mg *Token) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
+	owner := mg.Spec.ForProvider.Owner
 
 	var ref reference.To
-	switch mg.Spec.ForProvider.Owner.Type {
-	case "robots":
+	extract := reference.ExternalName()
+	switch {
+	case owner.Type == OwnerTypeRobots:
 		ref = reference.To{
 			List:    &RobotList{},
 			Managed: &Robot{},
 		}
+	case owner.Type == OwnerTypeUsers:
+		ref = reference.To{
+			List:    &UserList{},
+			Managed: &User{},
+		}
+		extract = UserID()
+	case owner.Type == OwnerTypeControlPlanes:
+		ref = reference.To{
+			List:    &controlplanev1alpha1.ControlPlaneList{},
+			Managed: &controlplanev1alpha1.ControlPlane{},
+		}
+		extract = controlplanev1alpha1.ControlPlaneID()
+	case owner.IDRef == nil && owner.IDSelector == nil:
+		// Owners given by ID do not need a kind to resolve against.
+		return errors.Wrap(ValidateOwnerID(owner.Type, owner.ID), "mg.Spec.ForProvider.Owner.ID")
 	default:
-		return errors.New("only robots is supported as owner type for reference resolution")
+		return errors.Errorf("owner references are not supported for owner type %s", owner.Type)
 	}
 
 	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Owner.ID),
-		Extract:      reference.ExternalName(),
+		Extract:      extract,
 		Reference:    mg.Spec.ForProvider.Owner.IDRef,
 		Selector:     mg.Spec.ForProvider.Owner.IDSelector,
 		To:           ref,
@@ -52,5 +82,116 @@ func (mg *Token) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.Owner.ID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.Owner.IDRef = rsp.ResolvedReference
 
+	return errors.Wrap(ValidateOwnerID(owner.Type, mg.Spec.ForProvider.Owner.ID), "mg.Spec.ForProvider.Owner.ID")
+}
+
+// ResolveReferences of this Team. It is not generated because the ID of the
+// organization is an integer, which generated references cannot resolve.
+func (mg *Team) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var id *string
+	if mg.Spec.ForProvider.OrganizationID != nil {
+		id = reference.ToPtrValue(strconv.Itoa(*mg.Spec.ForProvider.OrganizationID))
+	}
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      OrganizationID(),
+		Reference:    mg.Spec.ForProvider.OrganizationIDRef,
+		Selector:     mg.Spec.ForProvider.OrganizationIDSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.OrganizationID")
+	}
+	if rsp.ResolvedValue != "" {
+		oid, err := strconv.Atoi(rsp.ResolvedValue)
+		if err != nil {
+			return errors.Wrapf(err, "mg.Spec.ForProvider.OrganizationID: organization ID %q is not an integer", rsp.ResolvedValue)
+		}
+		mg.Spec.ForProvider.OrganizationID = &oid
+	}
+	mg.Spec.ForProvider.OrganizationIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.OrganizationName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrganizationRef,
+		Selector:     mg.Spec.ForProvider.OrganizationSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.OrganizationName")
+	}
+	mg.Spec.ForProvider.OrganizationName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OrganizationRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.RobotMembers {
+		m := &mg.Spec.ForProvider.RobotMembers[i]
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(m.RobotID),
+			Extract:      reference.ExternalName(),
+			Reference:    m.RobotIDRef,
+			Selector:     m.RobotIDSelector,
+			To: reference.To{
+				List:    &RobotList{},
+				Managed: &Robot{},
+			},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "mg.Spec.ForProvider.RobotMembers[%d].RobotID", i)
+		}
+		m.RobotID = reference.ToPtrValue(rsp.ResolvedValue)
+		m.RobotIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// UserID extracts the account ID of a User.
+func UserID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		u, ok := mg.(*User)
+		if !ok || u.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return strconv.FormatUint(uint64(u.Status.AtProvider.ID), 10)
+	}
+}
+
+// OrganizationID extracts the ID of an Organization as a decimal string.
+func OrganizationID() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		o, ok := mg.(*Organization)
+		if !ok || o.Status.AtProvider.ID == 0 {
+			return ""
+		}
+		return strconv.FormatUint(uint64(o.Status.AtProvider.ID), 10)
+	}
+}
+
+// ValidateOwnerID returns an error if the supplied ID is not in the format the
+// Upbound API expects for the supplied owner type. Users are identified by
+// integers, robots and control planes by UUIDs. An unset ID is valid.
+func ValidateOwnerID(typ string, id *string) error {
+	if id == nil || *id == "" {
+		return nil
+	}
+	switch typ {
+	case OwnerTypeUsers:
+		if _, err := strconv.ParseUint(*id, 10, 64); err != nil {
+			return errors.Errorf("owner ID %q of type %s must be an integer", *id, typ)
+		}
+	case OwnerTypeControlPlanes, OwnerTypeRobots:
+		if _, err := uuid.Parse(*id); err != nil {
+			return errors.Errorf("owner ID %q of type %s must be a UUID", *id, typ)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// Organization invite states.
+const (
+	InviteStatePending  = "Pending"
+	InviteStateAccepted = "Accepted"
+)
+
+// OrganizationInviteParameters are the configurable fields of an
+// OrganizationInvite.
+type OrganizationInviteParameters struct {
+	// OrganizationName is the name of the organization the user is invited
+	// to.
+	// +kubebuilder:validation:Required
+	// +immutable
+	OrganizationName string `json:"organizationName"`
+
+	// Email of the invited user.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Email string `json:"email"`
+
+	// Role of the user in the organization once the invite is accepted.
+	// +kubebuilder:validation:Enum=member;owner
+	// +kubebuilder:default=member
+	// +optional
+	// +immutable
+	Role *string `json:"role,omitempty"`
+
+	// TeamIDs of the teams the user joins once the invite is accepted. The
+	// provider adds the user to them when it observes that the invite was
+	// accepted, and again if they leave one while still a member of the
+	// organization.
+	// +crossplane:generate:reference:type=Team
+	// +crossplane:generate:reference:refFieldName=TeamIDRefs
+	// +crossplane:generate:reference:selectorFieldName=TeamIDSelector
+	// +optional
+	// +immutable
+	TeamIDs []string `json:"teamIds,omitempty"`
+
+	// TeamIDRefs references Teams to retrieve their teamIds.
+	// +optional
+	TeamIDRefs []xpv1.Reference `json:"teamIdRefs,omitempty"`
+
+	// TeamIDSelector selects references to Teams to retrieve their teamIds.
+	// +optional
+	TeamIDSelector *xpv1.Selector `json:"teamIdSelector,omitempty"`
+}
+
+// OrganizationInviteObservation are the observable fields of an
+// OrganizationInvite.
+type OrganizationInviteObservation struct {
+	// ID of the invite while it is pending.
+	ID int `json:"id,omitempty"`
+
+	// State of the invite, either Pending or Accepted.
+	State string `json:"state,omitempty"`
+
+	// UserID of the user that accepted the invite.
+	UserID int `json:"userId,omitempty"`
+}
+
+// An OrganizationInviteSpec defines the desired state of an
+// OrganizationInvite.
+type OrganizationInviteSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationInviteParameters `json:"forProvider"`
+}
+
+// An OrganizationInviteStatus represents the observed state of an
+// OrganizationInvite.
+type OrganizationInviteStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationInviteObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationInvite invites a user to an Upbound organization by email.
+// Deleting a pending OrganizationInvite revokes the invite. Deleting an
+// accepted one does not remove the user from the organization.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,upbound}
+type OrganizationInvite struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationInviteSpec   `json:"spec"`
+	Status OrganizationInviteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationInviteList contains a list of OrganizationInvite
+type OrganizationInviteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationInvite `json:"items"`
+}
+
+// OrganizationInvite type metadata.
+var (
+	OrganizationInviteKind             = reflect.TypeOf(OrganizationInvite{}).Name()
+	OrganizationInviteGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationInviteKind}.String()
+	OrganizationInviteKindAPIVersion   = OrganizationInviteKind + "." + SchemeGroupVersion.String()
+	OrganizationInviteGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationInviteKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationInvite{}, &OrganizationInviteList{})
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+
+	iamcommonv1alpha1 "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+)
+
+// PullSecretDistributionParameters are the configurable fields of a
+// PullSecretDistribution.
+type PullSecretDistributionParameters struct {
+	// TokenRef references the Token whose credentials are distributed. The
+	// credentials are read from the connection secret or the output Secret of
+	// the Token.
+	// +kubebuilder:validation:Required
+	TokenRef xpv1.Reference `json:"tokenRef"`
+
+	// NamespaceSelector selects the namespaces the credentials are copied
+	// into. An empty selector selects all namespaces.
+	// +kubebuilder:validation:Required
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	// SecretName is the name of the Secret written to every selected
+	// namespace. Defaults to the name of the PullSecretDistribution.
+	// +optional
+	SecretName *string `json:"secretName,omitempty"`
+
+	// Format of the written credentials. The token and id keys are always
+	// written. DockerConfigJSON adds a .dockerconfigjson key and makes the
+	// Secrets usable as image or package pull secrets.
+	// +kubebuilder:validation:Enum=Raw;DockerConfigJSON
+	// +kubebuilder:default=DockerConfigJSON
+	// +optional
+	Format iamcommonv1alpha1.TokenOutputFormat `json:"format,omitempty"`
+
+	// Registry is the host the docker config is written for when the format
+	// is DockerConfigJSON. Defaults to xpkg.upbound.io.
+	// +optional
+	Registry *string `json:"registry,omitempty"`
+}
+
+// PullSecretDistributionObservation are the observable fields of a
+// PullSecretDistribution.
+type PullSecretDistributionObservation struct {
+	// TokenID is the ID of the token whose credentials are distributed.
+	TokenID string `json:"tokenId,omitempty"`
+
+	// Namespaces the credentials are currently written to.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// A PullSecretDistributionSpec defines the desired state of a
+// PullSecretDistribution.
+type PullSecretDistributionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PullSecretDistributionParameters `json:"forProvider"`
+}
+
+// A PullSecretDistributionStatus represents the observed state of a
+// PullSecretDistribution.
+type PullSecretDistributionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PullSecretDistributionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PullSecretDistribution copies the credentials of a Token into a Secret in
+// every namespace that matches a label selector. The Secrets follow rotations
+// of the Token and are removed from namespaces that no longer match and when
+// the PullSecretDistribution is deleted. It is only available cluster-scoped
+// since it writes to namespaces other than its own. The provider needs
+// permission to list and watch namespaces.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TOKEN",type="string",JSONPath=".spec.forProvider.tokenRef.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,upbound}
+type PullSecretDistribution struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PullSecretDistributionSpec   `json:"spec"`
+	Status PullSecretDistributionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PullSecretDistributionList contains a list of PullSecretDistribution
+type PullSecretDistributionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PullSecretDistribution `json:"items"`
+}
+
+// PullSecretDistribution type metadata.
+var (
+	PullSecretDistributionKind             = reflect.TypeOf(PullSecretDistribution{}).Name()
+	PullSecretDistributionGroupKind        = schema.GroupKind{Group: Group, Kind: PullSecretDistributionKind}.String()
+	PullSecretDistributionKindAPIVersion   = PullSecretDistributionKind + "." + SchemeGroupVersion.String()
+	PullSecretDistributionGroupVersionKind = SchemeGroupVersion.WithKind(PullSecretDistributionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PullSecretDistribution{}, &PullSecretDistributionList{})
+}
@@ -29,12 +29,37 @@ type RobotOwner struct {
 	// possible value for that field, which is organization.
 
 	// ID of the organization that owns this robot. Takes precedence over name.
-	// Either name or id is required.
+	// When neither name nor id is specified, the organization of the
+	// ProviderConfig is used.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +crossplane:generate:reference:refFieldName=IDRef
+	// +crossplane:generate:reference:selectorFieldName=IDSelector
 	ID *string `json:"id,omitempty"`
 
-	// Name of the organization that owns this robot. Either name or id is required.
-	// It is used to look up the ID of the organization. Id field takes precedence.
+	// IDRef references an Organization to retrieve its ID.
+	// +optional
+	IDRef *xpv1.Reference `json:"idRef,omitempty"`
+
+	// IDSelector selects a reference to an Organization to retrieve its ID.
+	// +optional
+	IDSelector *xpv1.Selector `json:"idSelector,omitempty"`
+
+	// Name of the organization that owns this robot. It is used to look up
+	// the ID of the organization. Id field takes precedence.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:refFieldName=OrganizationRef
+	// +crossplane:generate:reference:selectorFieldName=OrganizationSelector
 	Name *string `json:"name,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to retrieve
+	// its name.
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
 }
 
 // RobotParameters are the configurable fields of a Robot.
@@ -48,13 +73,16 @@ type RobotParameters struct {
 	Description string `json:"description"`
 
 	// Owner that owns this robot.
-	// +kubebuilder:validation:Required
+	// +optional
 	Owner RobotOwner `json:"owner"`
 }
 
 // RobotObservation are the observable fields of a Robot.
 type RobotObservation struct {
 	ID string `json:"id"`
+
+	// OrganizationID is the ID of the organization that owns the Robot.
+	OrganizationID string `json:"organizationId,omitempty"`
 }
 
 // A RobotSpec defines the desired state of a Robot.
@@ -72,6 +100,10 @@ type RobotStatus struct {
 // +kubebuilder:object:root=true
 
 // A Robot is an example API type.
+// If a robot with the same name already exists in the organization, the Robot
+// reports a Conflict unless it is annotated with upbound.io/adopt-existing:
+// "true", in which case the existing robot is adopted. The provider can be
+// configured to adopt unless the annotation is "false" instead.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
@@ -0,0 +1,157 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+)
+
+// RobotAccountParameters are the configurable fields of a RobotAccount.
+type RobotAccountParameters struct {
+	// Name of the robot.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Name string `json:"name"`
+
+	// Description of the robot.
+	// +optional
+	// +immutable
+	Description string `json:"description,omitempty"`
+
+	// OrganizationName of the organization that owns the robot. Either the
+	// name directly or through organizationRef or organizationSelector is
+	// required.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:refFieldName=OrganizationRef
+	// +crossplane:generate:reference:selectorFieldName=OrganizationSelector
+	// +immutable
+	OrganizationName *string `json:"organizationName,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.Reference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to retrieve
+	// its name.
+	// +optional
+	OrganizationSelector *xpv1.Selector `json:"organizationSelector,omitempty"`
+
+	// TokenName is the name of the token issued for the robot. Defaults to
+	// the name of the robot. Changing it revokes the token and issues a new
+	// one with the new name.
+	// +optional
+	TokenName *string `json:"tokenName,omitempty"`
+
+	// Teams the robot is a member of. The robot is removed from teams that
+	// are not listed.
+	// +optional
+	Teams []RobotAccountTeam `json:"teams,omitempty"`
+
+	// Output configures the format the token is written in, both to the
+	// connection secret and to the optional output Secret, e.g. a pull
+	// secret for xpkg.upbound.io.
+	// +optional
+	Output *TokenOutput `json:"output,omitempty"`
+}
+
+// RobotAccountTeam is a team the robot of a RobotAccount is a member of.
+type RobotAccountTeam struct {
+	// TeamID of the team. Either teamId or teamIdRef or teamIdSelector is
+	// required.
+	// +crossplane:generate:reference:type=Team
+	TeamID *string `json:"teamId,omitempty"`
+
+	// TeamIDRef references a Team to retrieve its teamId.
+	TeamIDRef *xpv1.Reference `json:"teamIdRef,omitempty"`
+
+	// TeamIDSelector selects a reference to a Team in order to retrieve its
+	// teamId.
+	TeamIDSelector *xpv1.Selector `json:"teamIdSelector,omitempty"`
+}
+
+// RobotAccountObservation are the observable fields of a RobotAccount.
+type RobotAccountObservation struct {
+	// RobotID is the ID of the robot.
+	RobotID string `json:"robotId,omitempty"`
+
+	// TokenID is the ID of the token issued for the robot.
+	TokenID string `json:"tokenId,omitempty"`
+
+	// TokenName is the name the token was issued with. A token whose name no
+	// longer matches tokenName is revoked and issued again.
+	TokenName string `json:"tokenName,omitempty"`
+
+	// TeamIDs are the IDs of the teams the robot is a member of.
+	TeamIDs []string `json:"teamIds,omitempty"`
+}
+
+// A RobotAccountSpec defines the desired state of a RobotAccount.
+type RobotAccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RobotAccountParameters `json:"forProvider"`
+}
+
+// A RobotAccountStatus represents the observed state of a RobotAccount.
+type RobotAccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RobotAccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RobotAccount is a robot together with a token owned by it and its team
+// memberships. The token is written to the connection secret and optionally
+// to an output Secret. Its external name is the ID of the robot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,upbound}
+type RobotAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RobotAccountSpec   `json:"spec"`
+	Status RobotAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RobotAccountList contains a list of RobotAccount
+type RobotAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RobotAccount `json:"items"`
+}
+
+// RobotAccount type metadata.
+var (
+	RobotAccountKind             = reflect.TypeOf(RobotAccount{}).Name()
+	RobotAccountGroupKind        = schema.GroupKind{Group: Group, Kind: RobotAccountKind}.String()
+	RobotAccountKindAPIVersion   = RobotAccountKind + "." + SchemeGroupVersion.String()
+	RobotAccountGroupVersionKind = SchemeGroupVersion.WithKind(RobotAccountKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RobotAccount{}, &RobotAccountList{})
+}
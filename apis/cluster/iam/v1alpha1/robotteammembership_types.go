@@ -68,7 +68,10 @@ type RobotTeamMembershipStatus struct {
 
 // +kubebuilder:object:root=true
 
-// A RobotTeamMembership is an example API type.
+// A RobotTeamMembership is the membership of a robot in a team. Its external
+// name is of the form <robotID>/<teamID>. A membership can be imported by
+// setting only its external name, from which robotId and teamId are
+// late-initialized.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RepositoryGrant grants a team a permission on a repository.
+type RepositoryGrant struct {
+	// TeamID of the team to grant the permission to. Either teamId or
+	// teamIdRef or teamIdSelector is required.
+	// +crossplane:generate:reference:type=github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1.Team
+	TeamID *string `json:"teamId,omitempty"`
+
+	// TeamIDRef references a Team to and retrieves its teamId.
+	TeamIDRef *xpv1.Reference `json:"teamIdRef,omitempty"`
+
+	// TeamIDSelector selects a reference to a Team in order to retrieve its
+	// teamId.
+	TeamIDSelector *xpv1.Selector `json:"teamIdSelector,omitempty"`
+
+	// Permission is the permission to grant to the team on the repository.
+	// +kubebuilder:validation:Enum=admin;read;write;view
+	// +kubebuilder:validation:Required
+	Permission string `json:"permission"`
+}
+
+// RepositoryAccessPolicyParameters are the configurable fields of a
+// RepositoryAccessPolicy.
+type RepositoryAccessPolicyParameters struct {
+	// OrganizationName is the name of the organization to which the
+	// repository belongs.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	OrganizationName string `json:"organizationName"`
+
+	// Repository whose access is managed. Either repository or repositoryRef
+	// or repositorySelector is required.
+	// +crossplane:generate:reference:type=Repository
+	// +immutable
+	Repository *string `json:"repository,omitempty"`
+
+	// RepositoryRef references a Repository to and retrieves its name.
+	RepositoryRef *xpv1.Reference `json:"repositoryRef,omitempty"`
+
+	// RepositorySelector selects a reference to a Repository in order to
+	// retrieve its name.
+	RepositorySelector *xpv1.Selector `json:"repositorySelector,omitempty"`
+
+	// Grants are the permissions of teams on the repository. A policy that
+	// lists no grants and is annotated with upbound.io/adopt-existing: "true"
+	// adopts the grants the repository has before it is created.
+	// +optional
+	Grants []RepositoryGrant `json:"grants,omitempty"`
+
+	// Exclusive revokes the permissions of all teams that are not listed in
+	// grants. Otherwise permissions granted by other means are kept. The
+	// permissions of every team of the organization are listed on each poll
+	// of an exclusive policy, while other policies only list those of the
+	// teams in grants.
+	// +optional
+	Exclusive bool `json:"exclusive,omitempty"`
+}
+
+// ObservedRepositoryGrant is a permission of a team on a repository.
+type ObservedRepositoryGrant struct {
+	// TeamID of the team the permission is granted to.
+	TeamID string `json:"teamId"`
+
+	// Permission of the team on the repository.
+	Permission string `json:"permission"`
+}
+
+// RepositoryAccessPolicyObservation are the observable fields of a
+// RepositoryAccessPolicy.
+type RepositoryAccessPolicyObservation struct {
+	// Grants are the permissions of the teams listed in the policy.
+	Grants []ObservedRepositoryGrant `json:"grants,omitempty"`
+
+	// UnmanagedGrants are the permissions of teams that are not listed in the
+	// policy. They are only observed, and revoked, if the policy is exclusive.
+	UnmanagedGrants []ObservedRepositoryGrant `json:"unmanagedGrants,omitempty"`
+}
+
+// A RepositoryAccessPolicySpec defines the desired state of a
+// RepositoryAccessPolicy.
+type RepositoryAccessPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryAccessPolicyParameters `json:"forProvider"`
+}
+
+// A RepositoryAccessPolicyStatus represents the observed state of a
+// RepositoryAccessPolicy.
+type RepositoryAccessPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryAccessPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryAccessPolicy manages the permissions of teams on a repository.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXCLUSIVE",type="boolean",JSONPath=".spec.forProvider.exclusive"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,upbound}
+type RepositoryAccessPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryAccessPolicySpec   `json:"spec"`
+	Status RepositoryAccessPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryAccessPolicyList contains a list of RepositoryAccessPolicy
+type RepositoryAccessPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryAccessPolicy `json:"items"`
+}
+
+// RepositoryAccessPolicy type metadata.
+var (
+	RepositoryAccessPolicyKind             = reflect.TypeOf(RepositoryAccessPolicy{}).Name()
+	RepositoryAccessPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryAccessPolicyKind}.String()
+	RepositoryAccessPolicyKindAPIVersion   = RepositoryAccessPolicyKind + "." + SchemeGroupVersion.String()
+	RepositoryAccessPolicyGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryAccessPolicyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RepositoryAccessPolicy{}, &RepositoryAccessPolicyList{})
+}
@@ -25,6 +25,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservedRepositoryGrant) DeepCopyInto(out *ObservedRepositoryGrant) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservedRepositoryGrant.
+func (in *ObservedRepositoryGrant) DeepCopy() *ObservedRepositoryGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservedRepositoryGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Permission) DeepCopyInto(out *Permission) {
 	*out = *in
@@ -102,6 +117,16 @@ func (in *PermissionObservation) DeepCopy() *PermissionObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PermissionParameters) DeepCopyInto(out *PermissionParameters) {
 	*out = *in
+	if in.OrganizationRef != nil {
+		in, out := &in.OrganizationRef, &out.OrganizationRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrganizationSelector != nil {
+		in, out := &in.OrganizationSelector, &out.OrganizationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TeamID != nil {
 		in, out := &in.TeamID, &out.TeamID
 		*out = new(string)
@@ -205,6 +230,191 @@ func (in *Repository) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryAccessPolicy) DeepCopyInto(out *RepositoryAccessPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryAccessPolicy.
+func (in *RepositoryAccessPolicy) DeepCopy() *RepositoryAccessPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryAccessPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryAccessPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryAccessPolicyList) DeepCopyInto(out *RepositoryAccessPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RepositoryAccessPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryAccessPolicyList.
+func (in *RepositoryAccessPolicyList) DeepCopy() *RepositoryAccessPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryAccessPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryAccessPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryAccessPolicyObservation) DeepCopyInto(out *RepositoryAccessPolicyObservation) {
+	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]ObservedRepositoryGrant, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnmanagedGrants != nil {
+		in, out := &in.UnmanagedGrants, &out.UnmanagedGrants
+		*out = make([]ObservedRepositoryGrant, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryAccessPolicyObservation.
+func (in *RepositoryAccessPolicyObservation) DeepCopy() *RepositoryAccessPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryAccessPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryAccessPolicyParameters) DeepCopyInto(out *RepositoryAccessPolicyParameters) {
+	*out = *in
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(string)
+		**out = **in
+	}
+	if in.RepositoryRef != nil {
+		in, out := &in.RepositoryRef, &out.RepositoryRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepositorySelector != nil {
+		in, out := &in.RepositorySelector, &out.RepositorySelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]RepositoryGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryAccessPolicyParameters.
+func (in *RepositoryAccessPolicyParameters) DeepCopy() *RepositoryAccessPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryAccessPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryAccessPolicySpec) DeepCopyInto(out *RepositoryAccessPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryAccessPolicySpec.
+func (in *RepositoryAccessPolicySpec) DeepCopy() *RepositoryAccessPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryAccessPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryAccessPolicyStatus) DeepCopyInto(out *RepositoryAccessPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryAccessPolicyStatus.
+func (in *RepositoryAccessPolicyStatus) DeepCopy() *RepositoryAccessPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryAccessPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryGrant) DeepCopyInto(out *RepositoryGrant) {
+	*out = *in
+	if in.TeamID != nil {
+		in, out := &in.TeamID, &out.TeamID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeamIDRef != nil {
+		in, out := &in.TeamIDRef, &out.TeamIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamIDSelector != nil {
+		in, out := &in.TeamIDSelector, &out.TeamIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryGrant.
+func (in *RepositoryGrant) DeepCopy() *RepositoryGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
 	*out = *in
@@ -256,6 +466,26 @@ func (in *RepositoryObservation) DeepCopy() *RepositoryObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
 	*out = *in
+	if in.OrganizationRef != nil {
+		in, out := &in.OrganizationRef, &out.OrganizationRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrganizationSelector != nil {
+		in, out := &in.OrganizationSelector, &out.OrganizationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Publish != nil {
+		in, out := &in.Publish, &out.Publish
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryParameters.
@@ -272,7 +502,7 @@ func (in *RepositoryParameters) DeepCopy() *RepositoryParameters {
 func (in *RepositorySpec) DeepCopyInto(out *RepositorySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositorySpec.
@@ -28,6 +28,15 @@ func (l *PermissionList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this RepositoryAccessPolicyList.
+func (l *RepositoryAccessPolicyList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this RepositoryList.
 func (l *RepositoryList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -20,6 +20,7 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	controlplanev1alpha1 "github.com/upbound/provider-upbound/apis/cluster/controlplane/v1alpha1"
 	iamv1alpha1 "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
 	repository1alpha1 "github.com/upbound/provider-upbound/apis/cluster/repository/v1alpha1"
 	upboundv1alpha1 "github.com/upbound/provider-upbound/apis/cluster/v1alpha1"
@@ -28,6 +29,7 @@ import (
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
+		controlplanev1alpha1.SchemeBuilder.AddToScheme,
 		iamv1alpha1.SchemeBuilder.AddToScheme,
 		repository1alpha1.SchemeBuilder.AddToScheme,
 		upboundv1alpha1.SchemeBuilder.AddToScheme,
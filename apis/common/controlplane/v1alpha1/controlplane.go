@@ -0,0 +1,46 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// +kubebuilder:object:generate=true
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ControlPlaneConfigurationObservation is the observed state of the
+// configuration installed in a control plane.
+type ControlPlaneConfigurationObservation struct {
+	ID             string       `json:"id,omitempty"`
+	Name           *string      `json:"name,omitempty"`
+	Status         string       `json:"status,omitempty"`
+	CurrentVersion *string      `json:"currentVersion,omitempty"`
+	DesiredVersion *string      `json:"desiredVersion,omitempty"`
+	SyncedAt       *metav1.Time `json:"syncedAt,omitempty"`
+	DeployedAt     *metav1.Time `json:"deployedAt,omitempty"`
+}
+
+// ControlPlaneObservation are the observable fields of a ControlPlane.
+type ControlPlaneObservation struct {
+	ID            string                                `json:"id,omitempty"`
+	Status        string                                `json:"status,omitempty"`
+	Permission    string                                `json:"permission,omitempty"`
+	CreatorID     uint                                  `json:"creatorId,omitempty"`
+	Configuration *ControlPlaneConfigurationObservation `json:"configuration,omitempty"`
+	CreatedAt     *metav1.Time                          `json:"createdAt,omitempty"`
+	UpdatedAt     *metav1.Time                          `json:"updatedAt,omitempty"`
+}
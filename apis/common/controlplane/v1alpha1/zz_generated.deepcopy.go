@@ -0,0 +1,121 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationObservation) DeepCopyInto(out *ConfigurationObservation) {
+	*out = *in
+	if in.LatestVersion != nil {
+		in, out := &in.LatestVersion, &out.LatestVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationObservation.
+func (in *ConfigurationObservation) DeepCopy() *ConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneConfigurationObservation) DeepCopyInto(out *ControlPlaneConfigurationObservation) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.CurrentVersion != nil {
+		in, out := &in.CurrentVersion, &out.CurrentVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.DesiredVersion != nil {
+		in, out := &in.DesiredVersion, &out.DesiredVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.SyncedAt != nil {
+		in, out := &in.SyncedAt, &out.SyncedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DeployedAt != nil {
+		in, out := &in.DeployedAt, &out.DeployedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneConfigurationObservation.
+func (in *ControlPlaneConfigurationObservation) DeepCopy() *ControlPlaneConfigurationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneConfigurationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneObservation) DeepCopyInto(out *ControlPlaneObservation) {
+	*out = *in
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(ControlPlaneConfigurationObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneObservation.
+func (in *ControlPlaneObservation) DeepCopy() *ControlPlaneObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneObservation)
+	in.DeepCopyInto(out)
+	return out
+}
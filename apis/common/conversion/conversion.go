@@ -0,0 +1,96 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion converts managed resources between API versions.
+package conversion
+
+import (
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// A Modifier changes the unstructured content of the source of a conversion
+// into the shape of the destination.
+type Modifier func(p *fieldpath.Paved) error
+
+// Convert converts src to dst through their unstructured content, which the
+// supplied modifiers have to bring into the shape of dst. Fields dst does not
+// know are an error, so that conversions cannot silently drop fields. The
+// apiVersion and kind of dst are kept.
+func Convert(src, dst runtime.Object, mods ...Modifier) error {
+	p, err := fieldpath.PaveObject(src)
+	if err != nil {
+		return errors.Wrap(err, "cannot convert source to unstructured")
+	}
+	for _, m := range mods {
+		if err := m(p); err != nil {
+			return err
+		}
+	}
+	gvk := dst.GetObjectKind().GroupVersionKind()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(p.UnstructuredContent(), dst, true); err != nil {
+		return errors.Wrap(err, "cannot convert unstructured to destination")
+	}
+	dst.GetObjectKind().SetGroupVersionKind(gvk)
+	return nil
+}
+
+// Move returns a Modifier that moves the value at the supplied field path to
+// another one. It does nothing when the field is unset.
+func Move(from, to string) Modifier {
+	return func(p *fieldpath.Paved) error {
+		v, err := p.GetValue(from)
+		if fieldpath.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "cannot get %s", from)
+		}
+		if err := p.DeleteField(from); err != nil {
+			return errors.Wrapf(err, "cannot delete %s", from)
+		}
+		return errors.Wrapf(p.SetValue(to, v), "cannot set %s", to)
+	}
+}
+
+// Transform returns a Modifier that replaces the value at the supplied field
+// path with the result of the supplied function. It does nothing when the
+// field is unset.
+func Transform(path string, fn func(v any) (any, error)) Modifier {
+	return func(p *fieldpath.Paved) error {
+		v, err := p.GetValue(path)
+		if fieldpath.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "cannot get %s", path)
+		}
+		nv, err := fn(v)
+		if err != nil {
+			return errors.Wrapf(err, "cannot convert %s", path)
+		}
+		return errors.Wrapf(p.SetValue(path, nv), "cannot set %s", path)
+	}
+}
+
+// Delete returns a Modifier that deletes the field at the supplied field
+// path, e.g. an object whose fields have been moved elsewhere.
+func Delete(path string) Modifier {
+	return func(p *fieldpath.Paved) error {
+		return errors.Wrapf(p.DeleteField(path), "cannot delete %s", path)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// +kubebuilder:object:generate=true
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TokenRotation configures the scheduled rotation of a Token. When a rotation
+// is due a new token is issued and published to the connection secret, while
+// the previous token is kept valid for the overlap window and deleted after.
+type TokenRotation struct {
+	// Every is the interval after which a new token is issued, e.g. 720h for
+	// a rotation every 30 days.
+	// +kubebuilder:validation:Required
+	Every metav1.Duration `json:"every"`
+
+	// Overlap is how long the previous token stays valid after a rotation
+	// before it is deleted. Defaults to 24h.
+	// +optional
+	Overlap *metav1.Duration `json:"overlap,omitempty"`
+}
+
+// TokenObservation are the observable fields of a Token.
+type TokenObservation struct {
+	// CurrentTokenID is the ID of the token whose credentials are currently
+	// published to the connection secret.
+	CurrentTokenID string `json:"currentTokenId,omitempty"`
+
+	// CurrentTokenIssuedAt is the time the current token was issued.
+	CurrentTokenIssuedAt *metav1.Time `json:"currentTokenIssuedAt,omitempty"`
+
+	// NextRotationAt is the time the current token is due to be rotated.
+	// It is only set when rotation is configured.
+	NextRotationAt *metav1.Time `json:"nextRotationAt,omitempty"`
+
+	// PreviousTokenID is the ID of the token that was replaced by the last
+	// rotation and is still valid until PreviousTokenExpiresAt.
+	PreviousTokenID string `json:"previousTokenId,omitempty"`
+
+	// PreviousTokenExpiresAt is the time after which the previous token is
+	// deleted.
+	PreviousTokenExpiresAt *metav1.Time `json:"previousTokenExpiresAt,omitempty"`
+}
+
+// TokenOutputFormat is a format the credentials of a Token are written in.
+type TokenOutputFormat string
+
+// Token output formats.
+const (
+	// TokenOutputFormatRaw writes the token and its ID under the token and id
+	// keys.
+	TokenOutputFormatRaw TokenOutputFormat = "Raw"
+
+	// TokenOutputFormatDockerConfigJSON additionally writes a docker config
+	// for the configured registry under the .dockerconfigjson key.
+	TokenOutputFormatDockerConfigJSON TokenOutputFormat = "DockerConfigJSON"
+
+	// TokenOutputFormatUpProfile additionally writes an up CLI configuration
+	// under the config.json key. Its default profile holds a session the
+	// provider logged in with the token, and is refreshed before the session
+	// expires.
+	TokenOutputFormatUpProfile TokenOutputFormat = "UpProfile"
+)
+
+// DefaultTokenOutputRegistry is the registry host docker configs are written
+// for when no registry is configured.
+const DefaultTokenOutputRegistry = "xpkg.upbound.io"
+
+// TokenFormat configures the format the credentials of a Token are written
+// in.
+type TokenFormat struct {
+	// Format of the written credentials. The token and id keys are always
+	// written. DockerConfigJSON adds a .dockerconfigjson key and UpProfile adds
+	// a config.json key with an up CLI configuration.
+	// +kubebuilder:validation:Enum=Raw;DockerConfigJSON;UpProfile
+	// +kubebuilder:default=Raw
+	// +optional
+	Format TokenOutputFormat `json:"format,omitempty"`
+
+	// Registry is the host the docker config is written for when the format
+	// is DockerConfigJSON. Defaults to xpkg.upbound.io.
+	// +optional
+	Registry *string `json:"registry,omitempty"`
+}
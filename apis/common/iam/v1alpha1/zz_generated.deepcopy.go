@@ -0,0 +1,93 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenFormat) DeepCopyInto(out *TokenFormat) {
+	*out = *in
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenFormat.
+func (in *TokenFormat) DeepCopy() *TokenFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenObservation) DeepCopyInto(out *TokenObservation) {
+	*out = *in
+	if in.CurrentTokenIssuedAt != nil {
+		in, out := &in.CurrentTokenIssuedAt, &out.CurrentTokenIssuedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationAt != nil {
+		in, out := &in.NextRotationAt, &out.NextRotationAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousTokenExpiresAt != nil {
+		in, out := &in.PreviousTokenExpiresAt, &out.PreviousTokenExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenObservation.
+func (in *TokenObservation) DeepCopy() *TokenObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRotation) DeepCopyInto(out *TokenRotation) {
+	*out = *in
+	out.Every = in.Every
+	if in.Overlap != nil {
+		in, out := &in.Overlap, &out.Overlap
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRotation.
+func (in *TokenRotation) DeepCopy() *TokenRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRotation)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -20,12 +20,21 @@ limitations under the License.
 // NOTE: See the below link for details on what is happening here.
 // https://github.com/golang/go/wiki/Modules#how-can-i-track-tool-dependencies-for-a-module
 
-// Remove existing CRDs
-//go:generate rm -rf ../package/crds
+// Remove existing CRDs and webhook configurations
+//go:generate rm -rf ../package/crds ../package/webhookconfigurations
 
 // Generate deepcopy methodsets and CRD manifests
 //go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen object:headerFile=../hack/boilerplate.go.txt paths=./... crd:crdVersions=v1 output:artifacts:config=../package/crds
 
+// Configure the CRDs that serve more than one version to use the conversion webhook
+//go:generate go run -tags generate ../hack/conversion ../package/crds
+
+// Override the defaults of the common spec fields of the kinds that need different ones
+//go:generate go run -tags generate ../hack/defaults ../package/crds
+
+// Generate the validating webhook configuration
+//go:generate go run -tags generate sigs.k8s.io/controller-tools/cmd/controller-gen webhook paths=../internal/webhook/... output:webhook:artifacts:config=../package/webhookconfigurations
+
 // Generate crossplane-runtime methodsets (resource.Claim, etc)
 //go:generate go run -tags generate github.com/crossplane/crossplane-tools/cmd/angryjet generate-methodsets --header-file=../hack/boilerplate.go.txt ./...
 
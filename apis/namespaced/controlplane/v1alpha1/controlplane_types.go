@@ -0,0 +1,129 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ctpcommonv1alpha1 "github.com/upbound/provider-upbound/apis/common/controlplane/v1alpha1"
+)
+
+// ControlPlaneParameters are the configurable fields of a ControlPlane.
+type ControlPlaneParameters struct {
+	// Name of this ControlPlane.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Name string `json:"name"`
+
+	// OrganizationName is the name of the organization to which the control
+	// plane belongs.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	OrganizationName string `json:"organizationName"`
+
+	// Description of this ControlPlane.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// ConfigurationID is the ID of the configuration to install in the
+	// control plane.
+	// +crossplane:generate:reference:type=Configuration
+	// +crossplane:generate:reference:extractor=ConfigurationID()
+	// +optional
+	// +immutable
+	ConfigurationID *string `json:"configurationId,omitempty"`
+
+	// ConfigurationIDRef references a Configuration to retrieve its ID.
+	// +optional
+	ConfigurationIDRef *xpv1.NamespacedReference `json:"configurationIdRef,omitempty"`
+
+	// ConfigurationIDSelector selects a reference to a Configuration to
+	// retrieve its ID.
+	// +optional
+	ConfigurationIDSelector *xpv1.NamespacedSelector `json:"configurationIdSelector,omitempty"`
+
+	// KubeconfigTokenSecretRef references the token written to the kubeconfig
+	// in the connection secret, e.g. the token key of the connection secret of
+	// a Token owned by this control plane. The kubeconfig has no credentials
+	// when it is not set.
+	// +optional
+	KubeconfigTokenSecretRef *xpv1.LocalSecretKeySelector `json:"kubeconfigTokenSecretRef,omitempty"`
+}
+
+// ControlPlaneObservation are the observable fields of a ControlPlane.
+type ControlPlaneObservation struct {
+	ctpcommonv1alpha1.ControlPlaneObservation `json:",inline"`
+}
+
+// A ControlPlaneSpec defines the desired state of a ControlPlane.
+type ControlPlaneSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              ControlPlaneParameters `json:"forProvider"`
+}
+
+// A ControlPlaneStatus represents the observed state of a ControlPlane.
+type ControlPlaneStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ControlPlaneObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ControlPlane is an Upbound Cloud control plane. Its connection secret
+// contains a kubeconfig to reach it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,upbound}
+type ControlPlane struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ControlPlaneSpec   `json:"spec"`
+	Status ControlPlaneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ControlPlaneList contains a list of ControlPlane
+type ControlPlaneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ControlPlane `json:"items"`
+}
+
+// ControlPlane type metadata.
+var (
+	ControlPlaneKind             = reflect.TypeOf(ControlPlane{}).Name()
+	ControlPlaneGroupKind        = schema.GroupKind{Group: Group, Kind: ControlPlaneKind}.String()
+	ControlPlaneKindAPIVersion   = ControlPlaneKind + "." + SchemeGroupVersion.String()
+	ControlPlaneGroupVersionKind = SchemeGroupVersion.WithKind(ControlPlaneKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ControlPlane{}, &ControlPlaneList{})
+}
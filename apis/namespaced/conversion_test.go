@@ -0,0 +1,165 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/randfill"
+
+	iamv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
+	repositoryv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1alpha1"
+	repositoryv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
+)
+
+const fuzzIterations = 200
+
+// filler returns a randfill.Filler that fills objects with values that are
+// valid for the API server, which the conversions may rely on.
+func filler(seed int64) *randfill.Filler {
+	return randfill.NewWithSeed(seed).NilChance(0.2).NumElements(1, 3).Funcs(
+		// The apiVersion and kind of the destination are kept by conversions.
+		func(_ *metav1.TypeMeta, _ randfill.Continue) {},
+		func(m *metav1.ObjectMeta, c randfill.Continue) {
+			m.Name = c.String(0)
+			m.Namespace = c.String(0)
+			c.Fill(&m.Labels)
+			c.Fill(&m.Annotations)
+		},
+		// Times are serialized with a precision of seconds.
+		func(t *metav1.Time, c randfill.Continue) {
+			*t = metav1.Unix(c.Int63n(1<<32), 0)
+		},
+		func(p *iamv1beta1.TeamParameters, c randfill.Continue) {
+			c.FillNoCustom(p)
+			if p.OrganizationID != nil {
+				p.OrganizationID = ptr.To(strconv.FormatInt(c.Int63(), 10))
+			}
+		},
+		func(p *repositoryv1beta1.RepositoryParameters, c randfill.Continue) {
+			c.FillNoCustom(p)
+			if p.PublishPolicy != nil {
+				p.PublishPolicy = ptr.To([]string{repositoryv1beta1.PublishPolicyDraft, repositoryv1beta1.PublishPolicyPublish}[c.Intn(2)])
+			}
+		},
+	)
+}
+
+// conversions returns the spoke and hub versions of every kind that has a
+// hub version.
+func conversions(t *testing.T) map[string]struct {
+	spoke schema.GroupVersion
+	hub   schema.GroupVersion
+} {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+	gvs := []struct{ spoke, hub schema.GroupVersion }{
+		{spoke: iamv1alpha1.SchemeGroupVersion, hub: iamv1beta1.SchemeGroupVersion},
+		{spoke: repositoryv1alpha1.SchemeGroupVersion, hub: repositoryv1beta1.SchemeGroupVersion},
+	}
+	kinds := map[string]struct {
+		spoke schema.GroupVersion
+		hub   schema.GroupVersion
+	}{}
+	for _, gv := range gvs {
+		for kind := range s.KnownTypes(gv.hub) {
+			o, err := s.New(gv.hub.WithKind(kind))
+			if err != nil {
+				continue
+			}
+			if _, ok := o.(conversion.Hub); !ok {
+				continue
+			}
+			kinds[gv.hub.WithKind(kind).GroupKind().String()] = struct {
+				spoke schema.GroupVersion
+				hub   schema.GroupVersion
+			}{spoke: gv.spoke, hub: gv.hub}
+		}
+	}
+	return kinds
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %v", err)
+	}
+	kinds := conversions(t)
+	if len(kinds) == 0 {
+		t.Fatal("conversions(...): no kinds with a hub version")
+	}
+
+	for name, k := range kinds {
+		kind := schema.ParseGroupKind(name).Kind
+		newObject := func(gv schema.GroupVersion) runtime.Object {
+			o, err := s.New(gv.WithKind(kind))
+			if err != nil {
+				t.Fatalf("New(%s): %v", gv.WithKind(kind), err)
+			}
+			return o
+		}
+
+		t.Run(name+"/SpokeHubSpoke", func(t *testing.T) {
+			for i := range fuzzIterations {
+				want := newObject(k.spoke).(conversion.Convertible)
+				filler(int64(i)).Fill(want)
+
+				hub := newObject(k.hub).(conversion.Hub)
+				if err := want.ConvertTo(hub); err != nil {
+					t.Fatalf("ConvertTo(...): %v", err)
+				}
+				got := newObject(k.spoke).(conversion.Convertible)
+				if err := got.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom(...): %v", err)
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Fatalf("seed %d: -want, +got:\n%s", i, diff)
+				}
+			}
+		})
+
+		t.Run(name+"/HubSpokeHub", func(t *testing.T) {
+			for i := range fuzzIterations {
+				want := newObject(k.hub).(conversion.Hub)
+				filler(int64(i)).Fill(want)
+
+				spoke := newObject(k.spoke).(conversion.Convertible)
+				if err := spoke.ConvertFrom(want); err != nil {
+					t.Fatalf("ConvertFrom(...): %v", err)
+				}
+				got := newObject(k.hub).(conversion.Hub)
+				if err := spoke.ConvertTo(got); err != nil {
+					t.Fatalf("ConvertTo(...): %v", err)
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Fatalf("seed %d: -want, +got:\n%s", i, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	upconversion "github.com/upbound/provider-upbound/apis/common/conversion"
+)
+
+// ConvertTo converts this ControlPlanePermission to the hub version.
+func (mg *ControlPlanePermission) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this ControlPlanePermission.
+func (mg *ControlPlanePermission) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this Organization to the hub version.
+func (mg *Organization) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this Organization.
+func (mg *Organization) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this OrganizationInvite to the hub version.
+func (mg *OrganizationInvite) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this OrganizationInvite.
+func (mg *OrganizationInvite) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this OrganizationMember to the hub version.
+func (mg *OrganizationMember) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this OrganizationMember.
+func (mg *OrganizationMember) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this Robot to the hub version, which has the fields of
+// the owning organization directly in its parameters.
+func (mg *Robot) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub,
+		upconversion.Move("spec.forProvider.owner.id", "spec.forProvider.organizationId"),
+		upconversion.Move("spec.forProvider.owner.idRef", "spec.forProvider.organizationIdRef"),
+		upconversion.Move("spec.forProvider.owner.idSelector", "spec.forProvider.organizationIdSelector"),
+		upconversion.Move("spec.forProvider.owner.name", "spec.forProvider.organizationName"),
+		upconversion.Move("spec.forProvider.owner.organizationRef", "spec.forProvider.organizationRef"),
+		upconversion.Move("spec.forProvider.owner.organizationSelector", "spec.forProvider.organizationSelector"),
+		upconversion.Delete("spec.forProvider.owner"),
+	)
+}
+
+// ConvertFrom converts the hub version to this Robot.
+func (mg *Robot) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg,
+		upconversion.Move("spec.forProvider.organizationId", "spec.forProvider.owner.id"),
+		upconversion.Move("spec.forProvider.organizationIdRef", "spec.forProvider.owner.idRef"),
+		upconversion.Move("spec.forProvider.organizationIdSelector", "spec.forProvider.owner.idSelector"),
+		upconversion.Move("spec.forProvider.organizationName", "spec.forProvider.owner.name"),
+		upconversion.Move("spec.forProvider.organizationRef", "spec.forProvider.owner.organizationRef"),
+		upconversion.Move("spec.forProvider.organizationSelector", "spec.forProvider.owner.organizationSelector"),
+	)
+}
+
+// ConvertTo converts this RobotAccount to the hub version.
+func (mg *RobotAccount) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this RobotAccount.
+func (mg *RobotAccount) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this RobotTeamMembership to the hub version.
+func (mg *RobotTeamMembership) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this RobotTeamMembership.
+func (mg *RobotTeamMembership) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this Team to the hub version, which identifies the
+// organization by a decimal string.
+func (mg *Team) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub, upconversion.Transform("spec.forProvider.organizationId", func(v any) (any, error) {
+		id, ok := v.(int64)
+		if !ok {
+			return nil, errors.Errorf("organization ID %v is not an integer", v)
+		}
+		return strconv.FormatInt(id, 10), nil
+	}))
+}
+
+// ConvertFrom converts the hub version to this Team.
+func (mg *Team) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg, upconversion.Transform("spec.forProvider.organizationId", func(v any) (any, error) {
+		s, _ := v.(string)
+		id, err := strconv.ParseInt(s, 10, strconv.IntSize)
+		return id, errors.Wrapf(err, "organization ID %q is not an integer", s)
+	}))
+}
+
+// ConvertTo converts this Token to the hub version.
+func (mg *Token) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this Token.
+func (mg *Token) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this User to the hub version.
+func (mg *User) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this User.
+func (mg *User) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this UserTeamMembership to the hub version.
+func (mg *UserTeamMembership) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this UserTeamMembership.
+func (mg *UserTeamMembership) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// OrganizationMemberParameters are the configurable fields of an
+// OrganizationMember.
+type OrganizationMemberParameters struct {
+	// OrganizationName is the name of the organization the user is a member
+	// of.
+	// +kubebuilder:validation:Required
+	// +immutable
+	OrganizationName string `json:"organizationName"`
+
+	// Username of the member.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Username string `json:"username"`
+
+	// Role of the member in the organization. The current role of the member
+	// is kept when it is unset.
+	// +kubebuilder:validation:Enum=member;owner
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// OrganizationMemberObservation are the observable fields of an
+// OrganizationMember.
+type OrganizationMemberObservation struct {
+	// UserID of the member.
+	UserID int `json:"userId,omitempty"`
+
+	// Email of the member.
+	Email string `json:"email,omitempty"`
+
+	// Role of the member in the organization.
+	Role string `json:"role,omitempty"`
+}
+
+// An OrganizationMemberSpec defines the desired state of an
+// OrganizationMember. Its management policies default to all actions but
+// Delete.
+type OrganizationMemberSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              OrganizationMemberParameters `json:"forProvider"`
+}
+
+// An OrganizationMemberStatus represents the observed state of an
+// OrganizationMember.
+type OrganizationMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationMemberObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationMember manages the role of an existing member of an Upbound
+// organization. Users join an organization by accepting an invite, so an
+// OrganizationMember never adds a user and reports an error until the user
+// is a member.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".status.atProvider.role"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,upbound}
+type OrganizationMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationMemberSpec   `json:"spec"`
+	Status OrganizationMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationMemberList contains a list of OrganizationMember
+type OrganizationMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationMember `json:"items"`
+}
+
+// OrganizationMember type metadata.
+var (
+	OrganizationMemberKind             = reflect.TypeOf(OrganizationMember{}).Name()
+	OrganizationMemberGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationMemberKind}.String()
+	OrganizationMemberKindAPIVersion   = OrganizationMemberKind + "." + SchemeGroupVersion.String()
+	OrganizationMemberGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationMemberKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationMember{}, &OrganizationMemberList{})
+}
@@ -34,19 +34,75 @@ type TeamParameters struct {
 	Name string `json:"name"`
 
 	// OrganizationID of the Team. Takes precedence over the OrganizationName
-	// field. Either one of them must be specified.
+	// field. When neither is specified, the organization of the
+	// ProviderConfig is used.
 	OrganizationID *int `json:"organizationId,omitempty"`
 
+	// OrganizationIDRef references an Organization to retrieve its ID.
+	// +optional
+	OrganizationIDRef *xpv1.NamespacedReference `json:"organizationIdRef,omitempty"`
+
+	// OrganizationIDSelector selects a reference to an Organization to
+	// retrieve its ID.
+	// +optional
+	OrganizationIDSelector *xpv1.NamespacedSelector `json:"organizationIdSelector,omitempty"`
+
 	// OrganizationName of the Team. It is used to lookup the OrganizationID.
-	// OrganizationID takes precedence over this field. Either one of them must
-	// be specified.
+	// OrganizationID takes precedence over this field. When neither is
+	// specified, directly or through organizationRef or organizationSelector,
+	// the organization of the ProviderConfig is used.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:refFieldName=OrganizationRef
+	// +crossplane:generate:reference:selectorFieldName=OrganizationSelector
 	OrganizationName *string `json:"organizationName,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.NamespacedReference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to retrieve
+	// its name.
+	// +optional
+	OrganizationSelector *xpv1.NamespacedSelector `json:"organizationSelector,omitempty"`
+
+	// RobotMembers are the robots that are members of the Team. The robot
+	// members of the Team are not managed when it is unset.
+	// +optional
+	RobotMembers []TeamRobotMember `json:"robotMembers,omitempty"`
+
+	// Exclusive removes robots that are not listed in robotMembers from the
+	// Team. Otherwise robots added by other means, e.g. a RobotTeamMembership,
+	// are kept.
+	// +optional
+	Exclusive bool `json:"exclusive,omitempty"`
+}
+
+// TeamRobotMember is a robot that is a member of a Team.
+type TeamRobotMember struct {
+	// RobotID of the robot. Either robotId or robotIdRef or robotIdSelector is
+	// required.
+	// +crossplane:generate:reference:type=Robot
+	RobotID *string `json:"robotId,omitempty"`
+
+	// RobotIDRef references a Robot to and retrieves its robotId.
+	RobotIDRef *xpv1.NamespacedReference `json:"robotIdRef,omitempty"`
+
+	// RobotIDSelector selects a reference to a Robot in order to retrieve its
+	// robotId.
+	RobotIDSelector *xpv1.NamespacedSelector `json:"robotIdSelector,omitempty"`
 }
 
 // TeamObservation are the observable fields of a Team.
 type TeamObservation struct {
 	// ID of the Team.
 	ID string `json:"id,omitempty"`
+
+	// OrganizationID is the ID of the organization the Team belongs to.
+	OrganizationID string `json:"organizationId,omitempty"`
+
+	// RobotMembers are the IDs of the robots that are members of the Team.
+	// They are only observed when robotMembers is set.
+	RobotMembers []string `json:"robotMembers,omitempty"`
 }
 
 // A TeamSpec defines the desired state of a Team.
@@ -64,6 +120,10 @@ type TeamStatus struct {
 // +kubebuilder:object:root=true
 
 // A Team is an Upbound team that can be used to access Upbound services.
+// If a team with the same name already exists in the organization, the Team
+// reports a Conflict unless it is annotated with upbound.io/adopt-existing:
+// "true", in which case the existing team is adopted. The provider can be
+// configured to adopt unless the annotation is "false" instead.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
@@ -24,6 +24,8 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	iamcommonv1alpha1 "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
 )
 
 // Owner defines the owner of the token.
@@ -34,8 +36,8 @@ type Owner struct {
 	// +immutable
 	Type string `json:"type"`
 
-	// ID of the owner. It can be UUID or integer. Takes precedence over Name
-	// field.
+	// ID of the owner. It is an integer for users and a UUID for robots and
+	// controlPlanes. Takes precedence over IDRef and IDSelector.
 	// +immutable
 	ID *string `json:"id,omitempty"`
 
@@ -50,6 +52,18 @@ type Owner struct {
 	IDSelector *xpv1.Selector `json:"idSelector,omitempty"`
 }
 
+// TokenOutput configures the format the credentials of a Token are written in.
+type TokenOutput struct {
+	iamcommonv1alpha1.TokenFormat `json:",inline"`
+
+	// SecretRef is a Secret in the namespace of the Token the formatted
+	// credentials are written to. Unlike the connection secret, its type
+	// matches the format, e.g. kubernetes.io/dockerconfigjson, so that it can
+	// be referenced as an image or package pull secret.
+	// +optional
+	SecretRef *xpv1.LocalSecretReference `json:"secretRef,omitempty"`
+}
+
 // TokenParameters are the configurable fields of a Token.
 type TokenParameters struct {
 	// Name of the Token. This is different from the ID which is assigned by the
@@ -60,10 +74,22 @@ type TokenParameters struct {
 	// Owner of the Token.
 	// +kubebuilder:validation:Required
 	Owner Owner `json:"owner"`
+
+	// Rotation configures scheduled rotation of the Token. When unset, the
+	// Token is issued once and never rotated.
+	// +optional
+	Rotation *iamcommonv1alpha1.TokenRotation `json:"rotation,omitempty"`
+
+	// Output configures the format the credentials of the Token are written
+	// in, both to the connection secret and to the optional output Secret.
+	// +optional
+	Output *TokenOutput `json:"output,omitempty"`
 }
 
 // TokenObservation are the observable fields of a Token.
-type TokenObservation struct{}
+type TokenObservation struct {
+	iamcommonv1alpha1.TokenObservation `json:",inline"`
+}
 
 // A TokenSpec defines the desired state of a Token.
 type TokenSpec struct {
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// UserParameters are the configurable fields of a User.
+type UserParameters struct {
+	// Username of the user. Either username or email is required.
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// Email of the user. Users are looked up by email among the members of
+	// the organization given by organizationName. Username takes precedence.
+	// +optional
+	Email *string `json:"email,omitempty"`
+
+	// OrganizationName is the name of the organization whose members are
+	// searched when the user is looked up by email.
+	// +optional
+	OrganizationName *string `json:"organizationName,omitempty"`
+}
+
+// UserObservation are the observable fields of a User.
+type UserObservation struct {
+	// ID of the user account.
+	ID uint `json:"id,omitempty"`
+
+	// Username of the user.
+	Username string `json:"username,omitempty"`
+
+	// Email of the user, if visible to the provider's credentials.
+	Email string `json:"email,omitempty"`
+
+	// FirstName of the user.
+	FirstName string `json:"firstName,omitempty"`
+
+	// LastName of the user.
+	LastName string `json:"lastName,omitempty"`
+
+	// CreatedAt is the time the user account was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// A UserSpec defines the desired state of a User. Its management policies
+// default to Observe.
+type UserSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              UserParameters `json:"forProvider"`
+}
+
+// A UserStatus represents the observed state of a User.
+type UserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A User looks up an Upbound user by username or email and exposes their
+// account ID, so that other resources can reference them.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="integer",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".status.atProvider.username"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,upbound}
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec   `json:"spec"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of User
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
+
+// User type metadata.
+var (
+	UserKind             = reflect.TypeOf(User{}).Name()
+	UserGroupKind        = schema.GroupKind{Group: Group, Kind: UserKind}.String()
+	UserKindAPIVersion   = UserKind + "." + SchemeGroupVersion.String()
+	UserGroupVersionKind = SchemeGroupVersion.WithKind(UserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&User{}, &UserList{})
+}
@@ -22,9 +22,563 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	iamv1alpha1 "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlanePermission) DeepCopyInto(out *ControlPlanePermission) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlanePermission.
+func (in *ControlPlanePermission) DeepCopy() *ControlPlanePermission {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlanePermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlanePermission) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlanePermissionList) DeepCopyInto(out *ControlPlanePermissionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ControlPlanePermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlanePermissionList.
+func (in *ControlPlanePermissionList) DeepCopy() *ControlPlanePermissionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlanePermissionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControlPlanePermissionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlanePermissionObservation) DeepCopyInto(out *ControlPlanePermissionObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlanePermissionObservation.
+func (in *ControlPlanePermissionObservation) DeepCopy() *ControlPlanePermissionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlanePermissionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlanePermissionParameters) DeepCopyInto(out *ControlPlanePermissionParameters) {
+	*out = *in
+	if in.TeamID != nil {
+		in, out := &in.TeamID, &out.TeamID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeamIDRef != nil {
+		in, out := &in.TeamIDRef, &out.TeamIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamIDSelector != nil {
+		in, out := &in.TeamIDSelector, &out.TeamIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControlPlaneRef != nil {
+		in, out := &in.ControlPlaneRef, &out.ControlPlaneRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneSelector != nil {
+		in, out := &in.ControlPlaneSelector, &out.ControlPlaneSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlanePermissionParameters.
+func (in *ControlPlanePermissionParameters) DeepCopy() *ControlPlanePermissionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlanePermissionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlanePermissionSpec) DeepCopyInto(out *ControlPlanePermissionSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlanePermissionSpec.
+func (in *ControlPlanePermissionSpec) DeepCopy() *ControlPlanePermissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlanePermissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlanePermissionStatus) DeepCopyInto(out *ControlPlanePermissionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlanePermissionStatus.
+func (in *ControlPlanePermissionStatus) DeepCopy() *ControlPlanePermissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlanePermissionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Organization) DeepCopyInto(out *Organization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
+func (in *Organization) DeepCopy() *Organization {
+	if in == nil {
+		return nil
+	}
+	out := new(Organization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Organization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInvite) DeepCopyInto(out *OrganizationInvite) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInvite.
+func (in *OrganizationInvite) DeepCopy() *OrganizationInvite {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInvite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationInvite) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInviteList) DeepCopyInto(out *OrganizationInviteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationInvite, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInviteList.
+func (in *OrganizationInviteList) DeepCopy() *OrganizationInviteList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInviteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationInviteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInviteObservation) DeepCopyInto(out *OrganizationInviteObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInviteObservation.
+func (in *OrganizationInviteObservation) DeepCopy() *OrganizationInviteObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInviteObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInviteParameters) DeepCopyInto(out *OrganizationInviteParameters) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeamIDs != nil {
+		in, out := &in.TeamIDs, &out.TeamIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TeamIDRefs != nil {
+		in, out := &in.TeamIDRefs, &out.TeamIDRefs
+		*out = make([]v1.NamespacedReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TeamIDSelector != nil {
+		in, out := &in.TeamIDSelector, &out.TeamIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInviteParameters.
+func (in *OrganizationInviteParameters) DeepCopy() *OrganizationInviteParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInviteParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInviteSpec) DeepCopyInto(out *OrganizationInviteSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInviteSpec.
+func (in *OrganizationInviteSpec) DeepCopy() *OrganizationInviteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInviteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationInviteStatus) DeepCopyInto(out *OrganizationInviteStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationInviteStatus.
+func (in *OrganizationInviteStatus) DeepCopy() *OrganizationInviteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationInviteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Organization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
+func (in *OrganizationList) DeepCopy() *OrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMember) DeepCopyInto(out *OrganizationMember) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMember.
+func (in *OrganizationMember) DeepCopy() *OrganizationMember {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationMember) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberList) DeepCopyInto(out *OrganizationMemberList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberList.
+func (in *OrganizationMemberList) DeepCopy() *OrganizationMemberList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationMemberList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberObservation) DeepCopyInto(out *OrganizationMemberObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberObservation.
+func (in *OrganizationMemberObservation) DeepCopy() *OrganizationMemberObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberParameters) DeepCopyInto(out *OrganizationMemberParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberParameters.
+func (in *OrganizationMemberParameters) DeepCopy() *OrganizationMemberParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberSpec) DeepCopyInto(out *OrganizationMemberSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberSpec.
+func (in *OrganizationMemberSpec) DeepCopy() *OrganizationMemberSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationMemberStatus) DeepCopyInto(out *OrganizationMemberStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationMemberStatus.
+func (in *OrganizationMemberStatus) DeepCopy() *OrganizationMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationObservation) DeepCopyInto(out *OrganizationObservation) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationObservation.
+func (in *OrganizationObservation) DeepCopy() *OrganizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationParameters) DeepCopyInto(out *OrganizationParameters) {
+	*out = *in
+	if in.DisplayName != nil {
+		in, out := &in.DisplayName, &out.DisplayName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationParameters.
+func (in *OrganizationParameters) DeepCopy() *OrganizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
+func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
+func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Owner) DeepCopyInto(out *Owner) {
 	*out = *in
@@ -35,28 +589,387 @@ func (in *Owner) DeepCopyInto(out *Owner) {
 	}
 	if in.IDRef != nil {
 		in, out := &in.IDRef, &out.IDRef
-		*out = new(v1.Reference)
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IDSelector != nil {
+		in, out := &in.IDSelector, &out.IDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Owner.
+func (in *Owner) DeepCopy() *Owner {
+	if in == nil {
+		return nil
+	}
+	out := new(Owner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Robot) DeepCopyInto(out *Robot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Robot.
+func (in *Robot) DeepCopy() *Robot {
+	if in == nil {
+		return nil
+	}
+	out := new(Robot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Robot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotAccount) DeepCopyInto(out *RobotAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotAccount.
+func (in *RobotAccount) DeepCopy() *RobotAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RobotAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotAccountList) DeepCopyInto(out *RobotAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RobotAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotAccountList.
+func (in *RobotAccountList) DeepCopy() *RobotAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RobotAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotAccountObservation) DeepCopyInto(out *RobotAccountObservation) {
+	*out = *in
+	if in.TeamIDs != nil {
+		in, out := &in.TeamIDs, &out.TeamIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotAccountObservation.
+func (in *RobotAccountObservation) DeepCopy() *RobotAccountObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotAccountObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotAccountParameters) DeepCopyInto(out *RobotAccountParameters) {
+	*out = *in
+	if in.OrganizationName != nil {
+		in, out := &in.OrganizationName, &out.OrganizationName
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationRef != nil {
+		in, out := &in.OrganizationRef, &out.OrganizationRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrganizationSelector != nil {
+		in, out := &in.OrganizationSelector, &out.OrganizationSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenName != nil {
+		in, out := &in.TokenName, &out.TokenName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]RobotAccountTeam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(TokenOutput)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotAccountParameters.
+func (in *RobotAccountParameters) DeepCopy() *RobotAccountParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotAccountParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotAccountSpec) DeepCopyInto(out *RobotAccountSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotAccountSpec.
+func (in *RobotAccountSpec) DeepCopy() *RobotAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotAccountStatus) DeepCopyInto(out *RobotAccountStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotAccountStatus.
+func (in *RobotAccountStatus) DeepCopy() *RobotAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotAccountTeam) DeepCopyInto(out *RobotAccountTeam) {
+	*out = *in
+	if in.TeamID != nil {
+		in, out := &in.TeamID, &out.TeamID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeamIDRef != nil {
+		in, out := &in.TeamIDRef, &out.TeamIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamIDSelector != nil {
+		in, out := &in.TeamIDSelector, &out.TeamIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotAccountTeam.
+func (in *RobotAccountTeam) DeepCopy() *RobotAccountTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotAccountTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotList) DeepCopyInto(out *RobotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Robot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotList.
+func (in *RobotList) DeepCopy() *RobotList {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RobotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotObservation) DeepCopyInto(out *RobotObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotObservation.
+func (in *RobotObservation) DeepCopy() *RobotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotOwner) DeepCopyInto(out *RobotOwner) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IDRef != nil {
+		in, out := &in.IDRef, &out.IDRef
+		*out = new(v1.NamespacedReference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.IDSelector != nil {
 		in, out := &in.IDSelector, &out.IDSelector
-		*out = new(v1.Selector)
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.OrganizationRef != nil {
+		in, out := &in.OrganizationRef, &out.OrganizationRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrganizationSelector != nil {
+		in, out := &in.OrganizationSelector, &out.OrganizationSelector
+		*out = new(v1.NamespacedSelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Owner.
-func (in *Owner) DeepCopy() *Owner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotOwner.
+func (in *RobotOwner) DeepCopy() *RobotOwner {
 	if in == nil {
 		return nil
 	}
-	out := new(Owner)
+	out := new(RobotOwner)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Robot) DeepCopyInto(out *Robot) {
+func (in *RobotParameters) DeepCopyInto(out *RobotParameters) {
+	*out = *in
+	in.Owner.DeepCopyInto(&out.Owner)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotParameters.
+func (in *RobotParameters) DeepCopy() *RobotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotSpec) DeepCopyInto(out *RobotSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotSpec.
+func (in *RobotSpec) DeepCopy() *RobotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotStatus) DeepCopyInto(out *RobotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotStatus.
+func (in *RobotStatus) DeepCopy() *RobotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotTeamMembership) DeepCopyInto(out *RobotTeamMembership) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -64,18 +977,18 @@ func (in *Robot) DeepCopyInto(out *Robot) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Robot.
-func (in *Robot) DeepCopy() *Robot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembership.
+func (in *RobotTeamMembership) DeepCopy() *RobotTeamMembership {
 	if in == nil {
 		return nil
 	}
-	out := new(Robot)
+	out := new(RobotTeamMembership)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Robot) DeepCopyObject() runtime.Object {
+func (in *RobotTeamMembership) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -83,31 +996,31 @@ func (in *Robot) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotList) DeepCopyInto(out *RobotList) {
+func (in *RobotTeamMembershipList) DeepCopyInto(out *RobotTeamMembershipList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Robot, len(*in))
+		*out = make([]RobotTeamMembership, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotList.
-func (in *RobotList) DeepCopy() *RobotList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipList.
+func (in *RobotTeamMembershipList) DeepCopy() *RobotTeamMembershipList {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotList)
+	out := new(RobotTeamMembershipList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RobotList) DeepCopyObject() runtime.Object {
+func (in *RobotTeamMembershipList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -115,97 +1028,296 @@ func (in *RobotList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotObservation) DeepCopyInto(out *RobotObservation) {
+func (in *RobotTeamMembershipObservation) DeepCopyInto(out *RobotTeamMembershipObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipObservation.
+func (in *RobotTeamMembershipObservation) DeepCopy() *RobotTeamMembershipObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotTeamMembershipObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotTeamMembershipParameters) DeepCopyInto(out *RobotTeamMembershipParameters) {
+	*out = *in
+	if in.RobotID != nil {
+		in, out := &in.RobotID, &out.RobotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RobotIDRef != nil {
+		in, out := &in.RobotIDRef, &out.RobotIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RobotIDSelector != nil {
+		in, out := &in.RobotIDSelector, &out.RobotIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamID != nil {
+		in, out := &in.TeamID, &out.TeamID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeamIDRef != nil {
+		in, out := &in.TeamIDRef, &out.TeamIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamIDSelector != nil {
+		in, out := &in.TeamIDSelector, &out.TeamIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipParameters.
+func (in *RobotTeamMembershipParameters) DeepCopy() *RobotTeamMembershipParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotTeamMembershipParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotTeamMembershipSpec) DeepCopyInto(out *RobotTeamMembershipSpec) {
+	*out = *in
+	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipSpec.
+func (in *RobotTeamMembershipSpec) DeepCopy() *RobotTeamMembershipSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotTeamMembershipSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RobotTeamMembershipStatus) DeepCopyInto(out *RobotTeamMembershipStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipStatus.
+func (in *RobotTeamMembershipStatus) DeepCopy() *RobotTeamMembershipStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RobotTeamMembershipStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Team) DeepCopyInto(out *Team) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
+func (in *Team) DeepCopy() *Team {
+	if in == nil {
+		return nil
+	}
+	out := new(Team)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Team) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamList) DeepCopyInto(out *TeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Team, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
+func (in *TeamList) DeepCopy() *TeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamObservation) DeepCopyInto(out *TeamObservation) {
 	*out = *in
+	if in.RobotMembers != nil {
+		in, out := &in.RobotMembers, &out.RobotMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotObservation.
-func (in *RobotObservation) DeepCopy() *RobotObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamObservation.
+func (in *TeamObservation) DeepCopy() *TeamObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotObservation)
+	out := new(TeamObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotOwner) DeepCopyInto(out *RobotOwner) {
+func (in *TeamParameters) DeepCopyInto(out *TeamParameters) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
+	if in.OrganizationID != nil {
+		in, out := &in.OrganizationID, &out.OrganizationID
+		*out = new(int)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.OrganizationIDRef != nil {
+		in, out := &in.OrganizationIDRef, &out.OrganizationIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrganizationIDSelector != nil {
+		in, out := &in.OrganizationIDSelector, &out.OrganizationIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrganizationName != nil {
+		in, out := &in.OrganizationName, &out.OrganizationName
 		*out = new(string)
 		**out = **in
 	}
+	if in.OrganizationRef != nil {
+		in, out := &in.OrganizationRef, &out.OrganizationRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrganizationSelector != nil {
+		in, out := &in.OrganizationSelector, &out.OrganizationSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RobotMembers != nil {
+		in, out := &in.RobotMembers, &out.RobotMembers
+		*out = make([]TeamRobotMember, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotOwner.
-func (in *RobotOwner) DeepCopy() *RobotOwner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamParameters.
+func (in *TeamParameters) DeepCopy() *TeamParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotOwner)
+	out := new(TeamParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotParameters) DeepCopyInto(out *RobotParameters) {
+func (in *TeamRobotMember) DeepCopyInto(out *TeamRobotMember) {
 	*out = *in
-	in.Owner.DeepCopyInto(&out.Owner)
+	if in.RobotID != nil {
+		in, out := &in.RobotID, &out.RobotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RobotIDRef != nil {
+		in, out := &in.RobotIDRef, &out.RobotIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RobotIDSelector != nil {
+		in, out := &in.RobotIDSelector, &out.RobotIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotParameters.
-func (in *RobotParameters) DeepCopy() *RobotParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamRobotMember.
+func (in *TeamRobotMember) DeepCopy() *TeamRobotMember {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotParameters)
+	out := new(TeamRobotMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotSpec) DeepCopyInto(out *RobotSpec) {
+func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotSpec.
-func (in *RobotSpec) DeepCopy() *RobotSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
+func (in *TeamSpec) DeepCopy() *TeamSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotSpec)
+	out := new(TeamSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotStatus) DeepCopyInto(out *RobotStatus) {
+func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotStatus.
-func (in *RobotStatus) DeepCopy() *RobotStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
+func (in *TeamStatus) DeepCopy() *TeamStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotStatus)
+	out := new(TeamStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotTeamMembership) DeepCopyInto(out *RobotTeamMembership) {
+func (in *Token) DeepCopyInto(out *Token) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -213,18 +1325,18 @@ func (in *RobotTeamMembership) DeepCopyInto(out *RobotTeamMembership) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembership.
-func (in *RobotTeamMembership) DeepCopy() *RobotTeamMembership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Token.
+func (in *Token) DeepCopy() *Token {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotTeamMembership)
+	out := new(Token)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RobotTeamMembership) DeepCopyObject() runtime.Object {
+func (in *Token) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -232,31 +1344,31 @@ func (in *RobotTeamMembership) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotTeamMembershipList) DeepCopyInto(out *RobotTeamMembershipList) {
+func (in *TokenList) DeepCopyInto(out *TokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RobotTeamMembership, len(*in))
+		*out = make([]Token, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipList.
-func (in *RobotTeamMembershipList) DeepCopy() *RobotTeamMembershipList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenList.
+func (in *TokenList) DeepCopy() *TokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotTeamMembershipList)
+	out := new(TokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RobotTeamMembershipList) DeepCopyObject() runtime.Object {
+func (in *TokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -264,101 +1376,104 @@ func (in *RobotTeamMembershipList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotTeamMembershipObservation) DeepCopyInto(out *RobotTeamMembershipObservation) {
+func (in *TokenObservation) DeepCopyInto(out *TokenObservation) {
 	*out = *in
+	in.TokenObservation.DeepCopyInto(&out.TokenObservation)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipObservation.
-func (in *RobotTeamMembershipObservation) DeepCopy() *RobotTeamMembershipObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenObservation.
+func (in *TokenObservation) DeepCopy() *TokenObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotTeamMembershipObservation)
+	out := new(TokenObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotTeamMembershipParameters) DeepCopyInto(out *RobotTeamMembershipParameters) {
+func (in *TokenOutput) DeepCopyInto(out *TokenOutput) {
 	*out = *in
-	if in.RobotID != nil {
-		in, out := &in.RobotID, &out.RobotID
-		*out = new(string)
+	in.TokenFormat.DeepCopyInto(&out.TokenFormat)
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalSecretReference)
 		**out = **in
 	}
-	if in.RobotIDRef != nil {
-		in, out := &in.RobotIDRef, &out.RobotIDRef
-		*out = new(v1.NamespacedReference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RobotIDSelector != nil {
-		in, out := &in.RobotIDSelector, &out.RobotIDSelector
-		*out = new(v1.NamespacedSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TeamID != nil {
-		in, out := &in.TeamID, &out.TeamID
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenOutput.
+func (in *TokenOutput) DeepCopy() *TokenOutput {
+	if in == nil {
+		return nil
 	}
-	if in.TeamIDRef != nil {
-		in, out := &in.TeamIDRef, &out.TeamIDRef
-		*out = new(v1.NamespacedReference)
+	out := new(TokenOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenParameters) DeepCopyInto(out *TokenParameters) {
+	*out = *in
+	in.Owner.DeepCopyInto(&out.Owner)
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(iamv1alpha1.TokenRotation)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.TeamIDSelector != nil {
-		in, out := &in.TeamIDSelector, &out.TeamIDSelector
-		*out = new(v1.NamespacedSelector)
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(TokenOutput)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipParameters.
-func (in *RobotTeamMembershipParameters) DeepCopy() *RobotTeamMembershipParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenParameters.
+func (in *TokenParameters) DeepCopy() *TokenParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotTeamMembershipParameters)
+	out := new(TokenParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotTeamMembershipSpec) DeepCopyInto(out *RobotTeamMembershipSpec) {
+func (in *TokenSpec) DeepCopyInto(out *TokenSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipSpec.
-func (in *RobotTeamMembershipSpec) DeepCopy() *RobotTeamMembershipSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenSpec.
+func (in *TokenSpec) DeepCopy() *TokenSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotTeamMembershipSpec)
+	out := new(TokenSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RobotTeamMembershipStatus) DeepCopyInto(out *RobotTeamMembershipStatus) {
+func (in *TokenStatus) DeepCopyInto(out *TokenStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RobotTeamMembershipStatus.
-func (in *RobotTeamMembershipStatus) DeepCopy() *RobotTeamMembershipStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenStatus.
+func (in *TokenStatus) DeepCopy() *TokenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RobotTeamMembershipStatus)
+	out := new(TokenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Team) DeepCopyInto(out *Team) {
+func (in *User) DeepCopyInto(out *User) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -366,18 +1481,18 @@ func (in *Team) DeepCopyInto(out *Team) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Team.
-func (in *Team) DeepCopy() *Team {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new User.
+func (in *User) DeepCopy() *User {
 	if in == nil {
 		return nil
 	}
-	out := new(Team)
+	out := new(User)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Team) DeepCopyObject() runtime.Object {
+func (in *User) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -385,31 +1500,31 @@ func (in *Team) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamList) DeepCopyInto(out *TeamList) {
+func (in *UserList) DeepCopyInto(out *UserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Team, len(*in))
+		*out = make([]User, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamList.
-func (in *TeamList) DeepCopy() *TeamList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserList.
+func (in *UserList) DeepCopy() *UserList {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamList)
+	out := new(UserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TeamList) DeepCopyObject() runtime.Object {
+func (in *UserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -417,26 +1532,35 @@ func (in *TeamList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamObservation) DeepCopyInto(out *TeamObservation) {
+func (in *UserObservation) DeepCopyInto(out *UserObservation) {
 	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamObservation.
-func (in *TeamObservation) DeepCopy() *TeamObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserObservation.
+func (in *UserObservation) DeepCopy() *UserObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamObservation)
+	out := new(UserObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamParameters) DeepCopyInto(out *TeamParameters) {
+func (in *UserParameters) DeepCopyInto(out *UserParameters) {
 	*out = *in
-	if in.OrganizationID != nil {
-		in, out := &in.OrganizationID, &out.OrganizationID
-		*out = new(int)
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
 		**out = **in
 	}
 	if in.OrganizationName != nil {
@@ -446,52 +1570,52 @@ func (in *TeamParameters) DeepCopyInto(out *TeamParameters) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamParameters.
-func (in *TeamParameters) DeepCopy() *TeamParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserParameters.
+func (in *UserParameters) DeepCopy() *UserParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamParameters)
+	out := new(UserParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamSpec) DeepCopyInto(out *TeamSpec) {
+func (in *UserSpec) DeepCopyInto(out *UserSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamSpec.
-func (in *TeamSpec) DeepCopy() *TeamSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
+func (in *UserSpec) DeepCopy() *UserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamSpec)
+	out := new(UserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
+func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
-func (in *TeamStatus) DeepCopy() *TeamStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
+func (in *UserStatus) DeepCopy() *UserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TeamStatus)
+	out := new(UserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Token) DeepCopyInto(out *Token) {
+func (in *UserTeamMembership) DeepCopyInto(out *UserTeamMembership) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -499,18 +1623,18 @@ func (in *Token) DeepCopyInto(out *Token) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Token.
-func (in *Token) DeepCopy() *Token {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserTeamMembership.
+func (in *UserTeamMembership) DeepCopy() *UserTeamMembership {
 	if in == nil {
 		return nil
 	}
-	out := new(Token)
+	out := new(UserTeamMembership)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Token) DeepCopyObject() runtime.Object {
+func (in *UserTeamMembership) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -518,31 +1642,31 @@ func (in *Token) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TokenList) DeepCopyInto(out *TokenList) {
+func (in *UserTeamMembershipList) DeepCopyInto(out *UserTeamMembershipList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Token, len(*in))
+		*out = make([]UserTeamMembership, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenList.
-func (in *TokenList) DeepCopy() *TokenList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserTeamMembershipList.
+func (in *UserTeamMembershipList) DeepCopy() *UserTeamMembershipList {
 	if in == nil {
 		return nil
 	}
-	out := new(TokenList)
+	out := new(UserTeamMembershipList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TokenList) DeepCopyObject() runtime.Object {
+func (in *UserTeamMembershipList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -550,66 +1674,95 @@ func (in *TokenList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TokenObservation) DeepCopyInto(out *TokenObservation) {
+func (in *UserTeamMembershipObservation) DeepCopyInto(out *UserTeamMembershipObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenObservation.
-func (in *TokenObservation) DeepCopy() *TokenObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserTeamMembershipObservation.
+func (in *UserTeamMembershipObservation) DeepCopy() *UserTeamMembershipObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(TokenObservation)
+	out := new(UserTeamMembershipObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TokenParameters) DeepCopyInto(out *TokenParameters) {
+func (in *UserTeamMembershipParameters) DeepCopyInto(out *UserTeamMembershipParameters) {
 	*out = *in
-	in.Owner.DeepCopyInto(&out.Owner)
+	if in.TeamID != nil {
+		in, out := &in.TeamID, &out.TeamID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TeamIDRef != nil {
+		in, out := &in.TeamIDRef, &out.TeamIDRef
+		*out = new(v1.NamespacedReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamIDSelector != nil {
+		in, out := &in.TeamIDSelector, &out.TeamIDSelector
+		*out = new(v1.NamespacedSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenParameters.
-func (in *TokenParameters) DeepCopy() *TokenParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserTeamMembershipParameters.
+func (in *UserTeamMembershipParameters) DeepCopy() *UserTeamMembershipParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(TokenParameters)
+	out := new(UserTeamMembershipParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TokenSpec) DeepCopyInto(out *TokenSpec) {
+func (in *UserTeamMembershipSpec) DeepCopyInto(out *UserTeamMembershipSpec) {
 	*out = *in
 	in.ManagedResourceSpec.DeepCopyInto(&out.ManagedResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenSpec.
-func (in *TokenSpec) DeepCopy() *TokenSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserTeamMembershipSpec.
+func (in *UserTeamMembershipSpec) DeepCopy() *UserTeamMembershipSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(TokenSpec)
+	out := new(UserTeamMembershipSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TokenStatus) DeepCopyInto(out *TokenStatus) {
+func (in *UserTeamMembershipStatus) DeepCopyInto(out *UserTeamMembershipStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenStatus.
-func (in *TokenStatus) DeepCopy() *TokenStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserTeamMembershipStatus.
+func (in *UserTeamMembershipStatus) DeepCopy() *UserTeamMembershipStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TokenStatus)
+	out := new(UserTeamMembershipStatus)
 	in.DeepCopyInto(out)
 	return out
 }
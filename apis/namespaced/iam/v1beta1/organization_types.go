@@ -0,0 +1,126 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// OrganizationParameters are the configurable fields of an Organization.
+type OrganizationParameters struct {
+	// Name of the organization.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +immutable
+	Name string `json:"name"`
+
+	// DisplayName of the organization. It is only used when the organization
+	// is created.
+	// +optional
+	// +immutable
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// OrganizationObservation are the observable fields of an Organization.
+type OrganizationObservation struct {
+	// ID of the organization.
+	ID uint `json:"id,omitempty"`
+
+	// DisplayName of the organization.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// AccountType of the organization account.
+	AccountType string `json:"accountType,omitempty"`
+
+	// CreatorID is the ID of the user that created the organization.
+	CreatorID uint `json:"creatorId,omitempty"`
+
+	// Role of the provider's credentials in the organization.
+	Role string `json:"role,omitempty"`
+
+	// CreatedAt is the time the organization was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// MemberCount is the number of members of the organization.
+	MemberCount int `json:"memberCount"`
+
+	// RobotCount is the number of robots of the organization.
+	RobotCount int `json:"robotCount"`
+
+	// TeamCount is the number of teams of the organization.
+	TeamCount int `json:"teamCount"`
+}
+
+// An OrganizationSpec defines the desired state of an Organization. Its
+// management policies default to Observe.
+type OrganizationSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              OrganizationParameters `json:"forProvider"`
+}
+
+// An OrganizationStatus represents the observed state of an Organization.
+type OrganizationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Organization exposes the metadata of an Upbound organization, such as its
+// ID, and can be referenced by the organization fields of other resources.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="integer",JSONPath=".status.atProvider.id"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,upbound}
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationList contains a list of Organization
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
+
+// Organization type metadata.
+var (
+	OrganizationKind             = reflect.TypeOf(Organization{}).Name()
+	OrganizationGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationKind}.String()
+	OrganizationKindAPIVersion   = OrganizationKind + "." + SchemeGroupVersion.String()
+	OrganizationGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Organization{}, &OrganizationList{})
+}
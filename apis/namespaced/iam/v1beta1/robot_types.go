@@ -0,0 +1,139 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RobotParameters are the configurable fields of a Robot.
+type RobotParameters struct {
+	// Name of this Robot.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Description for this Robot.
+	// +kubebuilder:validation:Required
+	Description string `json:"description"`
+
+	// OrganizationID is the ID of the organization that owns this Robot.
+	// Takes precedence over OrganizationName. When neither of them is
+	// specified, the organization of the ProviderConfig is used.
+	// +optional
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:extractor=OrganizationID()
+	// +crossplane:generate:reference:refFieldName=OrganizationIDRef
+	// +crossplane:generate:reference:selectorFieldName=OrganizationIDSelector
+	OrganizationID *string `json:"organizationId,omitempty"`
+
+	// OrganizationIDRef references an Organization to retrieve its ID.
+	// +optional
+	OrganizationIDRef *xpv1.NamespacedReference `json:"organizationIdRef,omitempty"`
+
+	// OrganizationIDSelector selects a reference to an Organization to
+	// retrieve its ID.
+	// +optional
+	OrganizationIDSelector *xpv1.NamespacedSelector `json:"organizationIdSelector,omitempty"`
+
+	// OrganizationName is the name of the organization that owns this Robot.
+	// It is used to look up the OrganizationID. OrganizationID takes
+	// precedence. When neither is specified, directly or through
+	// organizationRef or organizationSelector, the organization of the
+	// ProviderConfig is used.
+	// +crossplane:generate:reference:type=Organization
+	// +crossplane:generate:reference:refFieldName=OrganizationRef
+	// +crossplane:generate:reference:selectorFieldName=OrganizationSelector
+	// +optional
+	OrganizationName *string `json:"organizationName,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.NamespacedReference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to retrieve
+	// its name.
+	// +optional
+	OrganizationSelector *xpv1.NamespacedSelector `json:"organizationSelector,omitempty"`
+}
+
+// RobotObservation are the observable fields of a Robot.
+type RobotObservation struct {
+	ID string `json:"id"`
+
+	// OrganizationID is the ID of the organization that owns the Robot.
+	OrganizationID string `json:"organizationId,omitempty"`
+}
+
+// A RobotSpec defines the desired state of a Robot.
+type RobotSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              RobotParameters `json:"forProvider"`
+}
+
+// A RobotStatus represents the observed state of a Robot.
+type RobotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RobotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Robot is an Upbound robot account of an organization.
+// If a robot with the same name already exists in the organization, the Robot
+// reports a Conflict unless it is annotated with upbound.io/adopt-existing:
+// "true", in which case the existing robot is adopted. The provider can be
+// configured to adopt unless the annotation is "false" instead.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,upbound}
+type Robot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RobotSpec   `json:"spec"`
+	Status RobotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RobotList contains a list of Robot
+type RobotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Robot `json:"items"`
+}
+
+// Robot type metadata.
+var (
+	RobotKind             = reflect.TypeOf(Robot{}).Name()
+	RobotGroupKind        = schema.GroupKind{Group: Group, Kind: RobotKind}.String()
+	RobotKindAPIVersion   = RobotKind + "." + SchemeGroupVersion.String()
+	RobotGroupVersionKind = SchemeGroupVersion.WithKind(RobotKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Robot{}, &RobotList{})
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+)
+
+// UserTeamMembershipParameters are the configurable fields of a UserTeamMembership.
+type UserTeamMembershipParameters struct {
+	// TeamID of the team to add the user to. Either teamId or teamIdRef or
+	// teamIdSelector is required.
+	// +crossplane:generate:reference:type=Team
+	TeamID *string `json:"teamId,omitempty"`
+
+	// TeamIDRef references a Team to and retrieves its teamId.
+	TeamIDRef *xpv1.NamespacedReference `json:"teamIdRef,omitempty"`
+
+	// TeamIDSelector selects a reference to a Team in order to retrieve its
+	// teamId.
+	TeamIDSelector *xpv1.NamespacedSelector `json:"teamIdSelector,omitempty"`
+
+	// Username of the user to add to the team. Takes precedence over Email.
+	// Either username or email is required.
+	// +immutable
+	Username *string `json:"username,omitempty"`
+
+	// Email of the user to add to the team. Either username or email is
+	// required.
+	// +immutable
+	Email *string `json:"email,omitempty"`
+
+	// Role of the user in the team.
+	// +kubebuilder:validation:Enum=member;owner
+	// +kubebuilder:default=member
+	// +optional
+	Role *string `json:"role,omitempty"`
+}
+
+// UserTeamMembershipObservation are the observable fields of a UserTeamMembership.
+type UserTeamMembershipObservation struct {
+	// UserID is the ID of the user in the team.
+	UserID int `json:"userId,omitempty"`
+
+	// Role of the user in the team.
+	Role string `json:"role,omitempty"`
+}
+
+// A UserTeamMembershipSpec defines the desired state of a UserTeamMembership.
+type UserTeamMembershipSpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              UserTeamMembershipParameters `json:"forProvider"`
+}
+
+// A UserTeamMembershipStatus represents the observed state of a UserTeamMembership.
+type UserTeamMembershipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserTeamMembershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UserTeamMembership adds an Upbound user to a team.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,upbound}
+type UserTeamMembership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserTeamMembershipSpec   `json:"spec"`
+	Status UserTeamMembershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserTeamMembershipList contains a list of UserTeamMembership
+type UserTeamMembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UserTeamMembership `json:"items"`
+}
+
+// UserTeamMembership type metadata.
+var (
+	UserTeamMembershipKind             = reflect.TypeOf(UserTeamMembership{}).Name()
+	UserTeamMembershipGroupKind        = schema.GroupKind{Group: Group, Kind: UserTeamMembershipKind}.String()
+	UserTeamMembershipKindAPIVersion   = UserTeamMembershipKind + "." + SchemeGroupVersion.String()
+	UserTeamMembershipGroupVersionKind = SchemeGroupVersion.WithKind(UserTeamMembershipKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&UserTeamMembership{}, &UserTeamMembershipList{})
+}
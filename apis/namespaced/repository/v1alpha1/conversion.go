@@ -0,0 +1,75 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	upconversion "github.com/upbound/provider-upbound/apis/common/conversion"
+	"github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
+)
+
+// ConvertTo converts this Permission to the hub version.
+func (mg *Permission) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this Permission.
+func (mg *Permission) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
+
+// ConvertTo converts this Repository to the hub version, which has a publish
+// policy instead of a publish flag.
+func (mg *Repository) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub,
+		upconversion.Move("spec.forProvider.publish", "spec.forProvider.publishPolicy"),
+		upconversion.Transform("spec.forProvider.publishPolicy", func(v any) (any, error) {
+			if publish, _ := v.(bool); publish {
+				return v1beta1.PublishPolicyPublish, nil
+			}
+			return v1beta1.PublishPolicyDraft, nil
+		}),
+	)
+}
+
+// ConvertFrom converts the hub version to this Repository.
+func (mg *Repository) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg,
+		upconversion.Move("spec.forProvider.publishPolicy", "spec.forProvider.publish"),
+		upconversion.Transform("spec.forProvider.publish", func(v any) (any, error) {
+			switch v {
+			case v1beta1.PublishPolicyPublish:
+				return true, nil
+			case v1beta1.PublishPolicyDraft:
+				return false, nil
+			}
+			return nil, errors.Errorf("unknown publish policy %v", v)
+		}),
+	)
+}
+
+// ConvertTo converts this RepositoryAccessPolicy to the hub version.
+func (mg *RepositoryAccessPolicy) ConvertTo(hub conversion.Hub) error {
+	return upconversion.Convert(mg, hub)
+}
+
+// ConvertFrom converts the hub version to this RepositoryAccessPolicy.
+func (mg *RepositoryAccessPolicy) ConvertFrom(hub conversion.Hub) error {
+	return upconversion.Convert(hub, mg)
+}
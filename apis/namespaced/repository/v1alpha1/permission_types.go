@@ -29,10 +29,23 @@ import (
 // PermissionParameters are the configurable fields of a Permission.
 type PermissionParameters struct {
 	// OrganizationName is the name of the organization to which the Permission
-	// belongs.
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinLength=1
-	OrganizationName string `json:"organizationName"`
+	// belongs. It can be specified directly or through organizationRef or
+	// organizationSelector. When it is not specified, the organization of the
+	// ProviderConfig is used.
+	// +crossplane:generate:reference:type=github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1.Organization
+	// +crossplane:generate:reference:refFieldName=OrganizationRef
+	// +crossplane:generate:reference:selectorFieldName=OrganizationSelector
+	// +optional
+	OrganizationName string `json:"organizationName,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.NamespacedReference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to retrieve
+	// its name.
+	// +optional
+	OrganizationSelector *xpv1.NamespacedSelector `json:"organizationSelector,omitempty"`
 
 	// Permission is the permission to grant to the repository for an team.
 	// +kubebuilder:validation:Enum=admin;read;write;view
@@ -65,7 +78,11 @@ type PermissionParameters struct {
 }
 
 // PermissionObservation are the observable fields of a Permission.
-type PermissionObservation struct{}
+type PermissionObservation struct {
+	// OrganizationName is the name of the organization the Permission belongs
+	// to.
+	OrganizationName string `json:"organizationName,omitempty"`
+}
 
 // A PermissionSpec defines the desired state of a Permission.
 type PermissionSpec struct {
@@ -81,7 +98,10 @@ type PermissionStatus struct {
 
 // +kubebuilder:object:root=true
 
-// A Permission is an API type.
+// A Permission grants a team access to a repository. Its external name is of
+// the form <organization>/<repository>/<teamID>. A permission can be imported
+// by setting only its external name, from which organizationName, repository
+// and teamId are late-initialized.
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
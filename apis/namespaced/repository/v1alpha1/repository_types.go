@@ -32,28 +32,48 @@ import (
 type RepositoryParameters struct {
 	// Name of this Repository.
 	// +kubebuilder:validation:Required
+	// +immutable
 	Name string `json:"name"`
 
 	// OrganizationName is the name of the organization to which the repository
-	// belongs.
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinLength=1
-	OrganizationName string `json:"organizationName"`
-
-	// Public determines the visibility of the repository
-	// +kubebuilder:validation:Required
-	// +kubebuilder:default=false
-	Public bool `json:"public"`
-
-	// Publish enables Upbound Marketplace listing page for the new repository
-	// +kubebuilder:validation:Required
-	// +kubebuilder:default=false
-	Publish bool `json:"publish"`
+	// belongs. It can be specified directly or through organizationRef or
+	// organizationSelector. When it is not specified, the organization of the
+	// ProviderConfig is used.
+	// +crossplane:generate:reference:type=github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1.Organization
+	// +crossplane:generate:reference:refFieldName=OrganizationRef
+	// +crossplane:generate:reference:selectorFieldName=OrganizationSelector
+	// +optional
+	OrganizationName string `json:"organizationName,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.NamespacedReference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to retrieve
+	// its name.
+	// +optional
+	OrganizationSelector *xpv1.NamespacedSelector `json:"organizationSelector,omitempty"`
+
+	// Public determines the visibility of the repository. Repositories are
+	// created private when it is unset, and an imported repository keeps its
+	// visibility.
+	// +optional
+	Public *bool `json:"public,omitempty"`
+
+	// Publish enables Upbound Marketplace listing page for the new repository.
+	// Repositories are created as drafts when it is unset, and an imported
+	// repository keeps its publish policy.
+	// +optional
+	Publish *bool `json:"publish,omitempty"`
 }
 
 // RepositoryObservation are the observable fields of a Repository.
 type RepositoryObservation struct {
 	repocommonv1alpha1.RepositoryObservation `json:",inline"`
+
+	// OrganizationName is the name of the organization the repository
+	// belongs to.
+	OrganizationName string `json:"organizationName,omitempty"`
 }
 
 // A RepositorySpec defines the desired state of a Repository.
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	xpv2 "github.com/crossplane/crossplane-runtime/v2/apis/common/v2"
+
+	repocommonv1alpha1 "github.com/upbound/provider-upbound/apis/common/repository/v1alpha1"
+)
+
+// RepositoryParameters are the configurable fields of a Repository.
+type RepositoryParameters struct {
+	// Name of this Repository.
+	// +kubebuilder:validation:Required
+	// +immutable
+	Name string `json:"name"`
+
+	// OrganizationName is the name of the organization to which the repository
+	// belongs. It can be specified directly or through organizationRef or
+	// organizationSelector. When it is not specified, the organization of the
+	// ProviderConfig is used.
+	// +crossplane:generate:reference:type=github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1.Organization
+	// +crossplane:generate:reference:refFieldName=OrganizationRef
+	// +crossplane:generate:reference:selectorFieldName=OrganizationSelector
+	// +optional
+	OrganizationName string `json:"organizationName,omitempty"`
+
+	// OrganizationRef references an Organization to retrieve its name.
+	// +optional
+	OrganizationRef *xpv1.NamespacedReference `json:"organizationRef,omitempty"`
+
+	// OrganizationSelector selects a reference to an Organization to retrieve
+	// its name.
+	// +optional
+	OrganizationSelector *xpv1.NamespacedSelector `json:"organizationSelector,omitempty"`
+
+	// Public determines the visibility of the repository. Repositories are
+	// created private when it is unset, and an imported repository keeps its
+	// visibility.
+	// +optional
+	Public *bool `json:"public,omitempty"`
+
+	// PublishPolicy of the repository. Repositories with the publish policy
+	// have an Upbound Marketplace listing page. Repositories are created as
+	// drafts when it is unset, and an imported repository keeps its publish
+	// policy.
+	// +kubebuilder:validation:Enum=draft;publish
+	// +optional
+	PublishPolicy *string `json:"publishPolicy,omitempty"`
+}
+
+// Repository publish policies.
+const (
+	// PublishPolicyDraft does not list the repository in the Upbound
+	// Marketplace.
+	PublishPolicyDraft = "draft"
+
+	// PublishPolicyPublish lists the repository in the Upbound Marketplace.
+	PublishPolicyPublish = "publish"
+)
+
+// RepositoryObservation are the observable fields of a Repository.
+type RepositoryObservation struct {
+	repocommonv1alpha1.RepositoryObservation `json:",inline"`
+
+	// OrganizationName is the name of the organization the repository
+	// belongs to.
+	OrganizationName string `json:"organizationName,omitempty"`
+}
+
+// A RepositorySpec defines the desired state of a Repository.
+type RepositorySpec struct {
+	xpv2.ManagedResourceSpec `json:",inline"`
+	ForProvider              RepositoryParameters `json:"forProvider"`
+}
+
+// A RepositoryStatus represents the observed state of a Repository.
+type RepositoryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Repository is an API type.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Namespaced,categories={crossplane,managed,upbound}
+type Repository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositorySpec   `json:"spec"`
+	Status RepositoryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryList contains a list of Repository
+type RepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Repository `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	RepositoryKind             = reflect.TypeOf(Repository{}).Name()
+	RepositoryGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryKind}.String()
+	RepositoryKindAPIVersion   = RepositoryKind + "." + SchemeGroupVersion.String()
+	RepositoryGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Repository{}, &RepositoryList{})
+}
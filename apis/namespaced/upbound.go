@@ -20,16 +20,22 @@ package apis
 import (
 	"k8s.io/apimachinery/pkg/runtime"
 
+	controlplanev1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/controlplane/v1alpha1"
 	iamv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
 	repository1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1alpha1"
+	repositoryv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
 	upboundv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/v1alpha1"
 )
 
 func init() {
 	// Register the types with the Scheme so the components can map objects to GroupVersionKinds and back
 	AddToSchemes = append(AddToSchemes,
+		controlplanev1alpha1.SchemeBuilder.AddToScheme,
 		iamv1alpha1.SchemeBuilder.AddToScheme,
+		iamv1beta1.SchemeBuilder.AddToScheme,
 		repository1alpha1.SchemeBuilder.AddToScheme,
+		repositoryv1beta1.SchemeBuilder.AddToScheme,
 		upboundv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
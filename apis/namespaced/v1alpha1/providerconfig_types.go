@@ -32,6 +32,47 @@ type ProviderConfigSpec struct {
 	pcv1alpha1common.ProviderConfigSpec `json:",inline"`
 }
 
+// A ClusterProviderConfigSpec defines the desired state of a
+// ClusterProviderConfig.
+type ClusterProviderConfigSpec struct {
+	pcv1alpha1common.ProviderConfigSpec `json:",inline"`
+
+	// Allow restricts which managed resources may use this
+	// ClusterProviderConfig and what they may act on. All managed resources
+	// may use it when it is unset.
+	// +optional
+	Allow *AllowList `json:"allow,omitempty"`
+}
+
+// An AllowList restricts the managed resources that may use a
+// ClusterProviderConfig. A managed resource must satisfy every restriction
+// that is set.
+type AllowList struct {
+	// NamespaceSelector selects the namespaces whose managed resources may
+	// use the ClusterProviderConfig. Managed resources of all namespaces may
+	// use it when it is unset. The provider must be allowed to get namespaces
+	// to evaluate it.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Organizations are the names of the organizations managed resources
+	// may act on, including the organization of the ClusterProviderConfig
+	// when they do not specify one. Managed resources may act on any
+	// organization when it is empty. Managed resources that specify their
+	// organization only by ID may not use the ClusterProviderConfig when it
+	// is set, since their organization cannot be verified. An ID specified
+	// alongside a name has to identify the same organization.
+	// +optional
+	Organizations []string `json:"organizations,omitempty"`
+
+	// Repositories are patterns of the names of the repositories managed
+	// resources may act on, e.g. team-a-*. Patterns use the syntax of Go's
+	// path.Match. Managed resources may act on any repository when it is
+	// empty.
+	// +optional
+	Repositories []string `json:"repositories,omitempty"`
+}
+
 // A ProviderConfigStatus reflects the observed state of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
@@ -69,8 +110,8 @@ type ClusterProviderConfig struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   ProviderConfigSpec   `json:"spec"`
-	Status ProviderConfigStatus `json:"status,omitempty"`
+	Spec   ClusterProviderConfigSpec `json:"spec"`
+	Status ProviderConfigStatus      `json:"status,omitempty"`
 }
 
 // ClusterProviderConfigList contains a list of ProviderConfig.
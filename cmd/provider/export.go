@@ -0,0 +1,103 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pcv1alpha1common "github.com/upbound/provider-upbound/apis/common/providerconfig/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/export"
+)
+
+// exportOptions are the flags of the export command.
+type exportOptions struct {
+	organization       *string
+	endpoint           *string
+	credentialsFile    *string
+	credentialsEnv     *string
+	scope              *string
+	namespace          *string
+	providerConfigName *string
+	providerConfigKind *string
+	output             *string
+}
+
+// runExport logs in to Upbound with the supplied credentials and writes the
+// resources of the supplied organization as observe-only managed resources.
+func runExport(ctx context.Context, o exportOptions) error {
+	// The credentials are read the same way as those of a ProviderConfig,
+	// only from a file or an environment variable instead of a Secret.
+	pc := &pcv1alpha1common.ProviderConfigSpec{
+		Organization: *o.organization,
+		Credentials: pcv1alpha1common.ProviderCredentials{
+			Source: xpv1.CredentialsSourceEnvironment,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				Env: &xpv1.EnvSelector{Name: *o.credentialsEnv},
+			},
+		},
+	}
+	if *o.credentialsFile != "" {
+		pc.Credentials.Source = xpv1.CredentialsSourceFilesystem
+		pc.Credentials.CommonCredentialSelectors = xpv1.CommonCredentialSelectors{
+			Fs: &xpv1.FsSelector{Path: *o.credentialsFile},
+		}
+	}
+	if *o.endpoint != "" {
+		pc.Endpoint = o.endpoint
+	}
+	cfg, _, err := upclient.NewConfig(ctx, nil, func(_ context.Context, _ client.Client) (*pcv1alpha1common.ProviderConfigSpec, error) {
+		return pc, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot log in to Upbound")
+	}
+
+	objs, err := export.New(cfg, export.Options{
+		Organization:       *o.organization,
+		Scope:              export.Scope(*o.scope),
+		Namespace:          *o.namespace,
+		ProviderConfigName: *o.providerConfigName,
+		ProviderConfigKind: *o.providerConfigKind,
+	}).Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The exported managed resources only observe when the provider honours
+	// their management policies, which is not the default.
+	fmt.Fprintln(os.Stderr, "The exported managed resources set managementPolicies, which requires the provider to run with --enable-management-policies.")
+
+	if *o.output == "" {
+		return export.Write(os.Stdout, objs)
+	}
+	f, err := os.Create(*o.output)
+	if err != nil {
+		return errors.Wrapf(err, "cannot create %s", *o.output)
+	}
+	if err := export.Write(f, objs); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return errors.Wrapf(f.Close(), "cannot close %s", *o.output)
+}
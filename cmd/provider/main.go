@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"path/filepath"
@@ -29,6 +30,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/crossplane/crossplane-runtime/v2/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/feature"
@@ -39,9 +41,14 @@ import (
 
 	apiscluster "github.com/upbound/provider-upbound/apis/cluster"
 	apis "github.com/upbound/provider-upbound/apis/namespaced"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
+	repov1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
 	"github.com/upbound/provider-upbound/internal/bootcheck"
 	upbound "github.com/upbound/provider-upbound/internal/controller"
+	"github.com/upbound/provider-upbound/internal/export"
 	"github.com/upbound/provider-upbound/internal/features"
+	"github.com/upbound/provider-upbound/internal/storageversion"
+	upwebhook "github.com/upbound/provider-upbound/internal/webhook"
 )
 
 func init() {
@@ -61,14 +68,47 @@ func main() {
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
 
-		enableManagementPolicies = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+		enableManagementPolicies  = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+		tlsServerCertsDir         = app.Flag("tls-server-certs-dir", "Directory holding the TLS certificate and key of the webhook server. The validating and conversion webhooks are only served when it is set, which Crossplane does when it runs the provider.").Envar("TLS_SERVER_CERTS_DIR").String()
+		requireAdoptionAnnotation = app.Flag("require-adoption-annotation", "Adopt existing Upbound robots and teams by name only when the managed resource is annotated with upbound.io/adopt-existing: \"true\". When disabled they are adopted unless annotated with \"false\".").Default("true").Envar("REQUIRE_ADOPTION_ANNOTATION").Bool()
+
+		exportCmd  = app.Command("export", "Export the resources of an Upbound organization as observe-only managed resources.")
+		exportOpts = exportOptions{
+			organization:       exportCmd.Flag("organization", "Upbound organization to export.").Required().String(),
+			endpoint:           exportCmd.Flag("endpoint", "Upbound API endpoint. Defaults to https://api.upbound.io.").String(),
+			credentialsFile:    exportCmd.Flag("credentials-file", "File holding the credentials used to log in, in the same formats as the credentials of a ProviderConfig.").ExistingFile(),
+			credentialsEnv:     exportCmd.Flag("credentials-env", "Environment variable holding the credentials used to log in when no credentials file is given.").Default("UPBOUND_TOKEN").String(),
+			scope:              exportCmd.Flag("scope", "Scope of the exported managed resources.").Default(string(export.ScopeNamespaced)).Enum(string(export.ScopeCluster), string(export.ScopeNamespaced)),
+			namespace:          exportCmd.Flag("namespace", "Namespace of the exported namespaced managed resources.").Default("default").String(),
+			providerConfigName: exportCmd.Flag("provider-config", "Name of the provider config the exported managed resources reference. The default provider config is used when unset.").String(),
+			providerConfigKind: exportCmd.Flag("provider-config-kind", "Kind of the provider config the exported namespaced managed resources reference.").Default("ClusterProviderConfig").Enum("ClusterProviderConfig", "ProviderConfig"),
+			output:             exportCmd.Flag("output", "File the managed resources are written to. Defaults to stdout.").Short('o').String(),
+		}
+
+		migrateCmd  = app.Command("migrate", "Migrate legacy cluster-scoped managed resources and ProviderConfigs to their namespaced equivalents.")
+		migrateOpts = migrateOptions{
+			namespace:          migrateCmd.Flag("namespace", "Namespace the namespaced managed resources are created in.").Default("default").String(),
+			providerConfigKind: migrateCmd.Flag("provider-config-kind", "Kind legacy ProviderConfigs are converted to and the migrated managed resources reference.").Default("ClusterProviderConfig").Enum("ClusterProviderConfig", "ProviderConfig"),
+			dryRun:             migrateCmd.Flag("dry-run", "Only report the resources that would be migrated.").Bool(),
+		}
 	)
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+	app.Command("start", "Start the provider.").Default()
+	cmd := kingpin.MustParse(app.Parse(os.Args[1:]))
 
 	zl := zap.New(zap.UseDevMode(*debug))
-	logger := logging.NewLogrLogger(zl.WithName("provider-upbound"))
 	ctrl.SetLogger(zl)
 
+	switch cmd {
+	case exportCmd.FullCommand():
+		kingpin.FatalIfError(runExport(context.Background(), exportOpts), "Cannot export organization")
+		return
+	case migrateCmd.FullCommand():
+		kingpin.FatalIfError(runMigrate(context.Background(), logging.NewLogrLogger(zl.WithName("migrate")), migrateOpts), "Cannot migrate legacy managed resources")
+		return
+	}
+
+	logger := logging.NewLogrLogger(zl.WithName("provider-upbound"))
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
@@ -81,6 +121,9 @@ func main() {
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+		WebhookServer: webhook.NewServer(webhook.Options{
+			CertDir: *tlsServerCertsDir,
+		}),
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apiscluster.AddToScheme(mgr.GetScheme()), "Cannot add cluster-scoped Upbound MR APIs to scheme")
@@ -101,7 +144,19 @@ func main() {
 		logger.Info("Alpha feature enabled", "flag", features.EnableAlphaManagementPolicies)
 	}
 
+	if *requireAdoptionAnnotation {
+		o.Features.Enable(features.RequireAdoptionAnnotation)
+		logger.Info("Feature enabled", "flag", features.RequireAdoptionAnnotation)
+	}
+
 	kingpin.FatalIfError(upbound.Setup(mgr, o), "Cannot setup Upbound controllers")
 	kingpin.FatalIfError(customresourcesgate.Setup(mgr, o), "Cannot setup CustomResourcesGate controller")
+	if *tlsServerCertsDir != "" {
+		kingpin.FatalIfError(upwebhook.Setup(mgr), "Cannot setup webhooks")
+		// Objects stored in an older version can only be read through the
+		// conversion webhook, so they are migrated only when it is served.
+		kinds := storageversion.Kinds(mgr.GetScheme(), iamv1beta1.SchemeGroupVersion, repov1beta1.SchemeGroupVersion)
+		kingpin.FatalIfError(mgr.Add(storageversion.New(mgr.GetClient(), mgr.GetAPIReader(), logger.WithValues("controller", "storageversion"), kinds...)), "Cannot setup storage version migration")
+	}
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
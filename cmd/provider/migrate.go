@@ -0,0 +1,72 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiscluster "github.com/upbound/provider-upbound/apis/cluster"
+	apis "github.com/upbound/provider-upbound/apis/namespaced"
+	"github.com/upbound/provider-upbound/internal/migrate"
+)
+
+// migrateOptions are the flags of the migrate command.
+type migrateOptions struct {
+	namespace          *string
+	providerConfigKind *string
+	dryRun             *bool
+}
+
+// runMigrate converts the legacy cluster-scoped managed resources and
+// ProviderConfigs of the cluster into their namespaced equivalents.
+func runMigrate(ctx context.Context, log logging.Logger, o migrateOptions) error {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return errors.Wrap(err, "cannot get API server rest config")
+	}
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		return errors.Wrap(err, "cannot add core Kubernetes APIs to scheme")
+	}
+	if err := apiscluster.AddToScheme(s); err != nil {
+		return errors.Wrap(err, "cannot add cluster-scoped Upbound MR APIs to scheme")
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		return errors.Wrap(err, "cannot add namespace-scoped Upbound MR APIs to scheme")
+	}
+	kube, err := client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		return errors.Wrap(err, "cannot create Kubernetes client")
+	}
+
+	m := migrate.New(kube, log, migrate.Options{
+		Namespace:          *o.namespace,
+		ProviderConfigKind: *o.providerConfigKind,
+		DryRun:             *o.dryRun,
+	})
+	if err := m.Skip(ctx, migrate.Unsupported(s)); err != nil {
+		return err
+	}
+	return m.Migrate(ctx, migrate.Kinds(s))
+}
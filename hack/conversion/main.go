@@ -0,0 +1,75 @@
+//go:build generate
+// +build generate
+
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command conversion configures the CRDs in the supplied directory that serve
+// more than one version to be converted by the conversion webhook of the
+// provider. Crossplane fills in the client config of the webhook when it
+// installs the provider.
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// conversion is inserted as the first field of the spec of the CRDs, which is
+// where controller-gen's alphabetical field order puts it.
+const conversion = `  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        service:
+          name: webhook-service
+          namespace: system
+          path: /convert
+      conversionReviewVersions:
+      - v1
+`
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalf("usage: %s <crd directory>", os.Args[0])
+	}
+	files, err := filepath.Glob(filepath.Join(os.Args[1], "*.yaml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range files {
+		b, err := os.ReadFile(f) //nolint:gosec // The files are generated CRDs.
+		if err != nil {
+			log.Fatal(err)
+		}
+		crd := &extv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(b, crd); err != nil {
+			log.Fatalf("cannot parse %s: %v", f, err)
+		}
+		if len(crd.Spec.Versions) < 2 || crd.Spec.Conversion != nil {
+			continue
+		}
+		b = bytes.Replace(b, []byte("\nspec:\n"), []byte("\nspec:\n"+conversion), 1)
+		if err := os.WriteFile(f, b, 0o644); err != nil { //nolint:gosec // CRDs are not secret.
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+//go:build generate
+// +build generate
+
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command defaults overrides the defaults of the common managed resource spec
+// fields in the CRDs in the supplied directory for the kinds that need
+// different ones. These kinds embed the common spec so that their managed
+// method sets are generated, and controller-gen cannot override the markers
+// of embedded fields.
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// A field of the spec of a CRD whose default is overridden.
+type field struct {
+	Default     any
+	Description string
+}
+
+const (
+	observeUsers = "ManagementPolicies specify the array of actions Crossplane is allowed to\n" +
+		"take on the managed and external resources. They default to Observe,\n" +
+		"since users cannot be created, updated or deleted."
+
+	observeOrganizations = "ManagementPolicies specify the array of actions Crossplane is allowed to\n" +
+		"take on the managed and external resources. They default to Observe, so\n" +
+		"that an Organization only exposes the metadata of an existing\n" +
+		"organization. Use \"*\" to create and delete the organization."
+)
+
+// overrides are the overridden spec fields by CRD name.
+var overrides = map[string]map[string]field{
+	"users.iam.upbound.io": {
+		"managementPolicies": {Default: []any{"Observe"}, Description: observeUsers},
+		"deletionPolicy":     {Default: "Orphan", Description: "DeletionPolicy has no effect since users are never deleted."},
+	},
+	"users.iam.m.upbound.io": {
+		"managementPolicies": {Default: []any{"Observe"}, Description: observeUsers},
+	},
+	"organizations.iam.upbound.io": {
+		"managementPolicies": {Default: []any{"Observe"}, Description: observeOrganizations},
+	},
+	"organizations.iam.m.upbound.io": {
+		"managementPolicies": {Default: []any{"Observe"}, Description: observeOrganizations},
+	},
+	"organizationmembers.iam.upbound.io": {
+		"deletionPolicy": {Default: "Orphan", Description: "DeletionPolicy specifies what will happen to the member when this\n" +
+			"managed resource is deleted - either \"Delete\" to remove the user from\n" +
+			"the organization or \"Orphan\" to keep them. Defaults to Orphan so that\n" +
+			"deleting the managed resource does not lock anyone out by accident."},
+	},
+	"organizationmembers.iam.m.upbound.io": {
+		"managementPolicies": {Default: []any{"Observe", "Create", "Update", "LateInitialize"}, Description: "ManagementPolicies specify the array of actions Crossplane is allowed to\n" +
+			"take on the managed and external resources. They default to all actions\n" +
+			"but Delete, so that deleting the managed resource does not remove the\n" +
+			"user from the organization by accident. Add Delete or use \"*\" to remove\n" +
+			"the member on deletion."},
+	},
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		log.Fatalf("usage: %s <crd directory>", os.Args[0])
+	}
+	for name, fields := range overrides {
+		plural, group, _ := strings.Cut(name, ".")
+		f := filepath.Join(os.Args[1], group+"_"+plural+".yaml")
+		b, err := os.ReadFile(f) //nolint:gosec // The files are generated CRDs.
+		if err != nil {
+			log.Fatal(err)
+		}
+		// The CRD is edited as a map, whose keys are marshalled in the same
+		// order as controller-gen writes them.
+		crd := map[string]any{}
+		if err := yaml.Unmarshal(b, &crd); err != nil {
+			log.Fatalf("cannot parse %s: %v", f, err)
+		}
+		for _, v := range lookup(crd, "spec", "versions").([]any) {
+			props := lookup(v, "schema", "openAPIV3Schema", "properties", "spec", "properties").(map[string]any)
+			for n, fd := range fields {
+				p, ok := props[n].(map[string]any)
+				if !ok {
+					log.Fatalf("%s has no spec field %s", f, n)
+				}
+				p["default"] = fd.Default
+				p["description"] = fd.Description
+			}
+		}
+		out, err := yaml.Marshal(crd)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(f, append([]byte("---\n"), out...), 0o644); err != nil { //nolint:gosec // CRDs are not secret.
+			log.Fatal(err)
+		}
+	}
+}
+
+// lookup returns the value at the supplied path of nested maps.
+func lookup(o any, path ...string) any {
+	for _, k := range path {
+		m, ok := o.(map[string]any)
+		if !ok {
+			log.Fatalf("cannot look up %s", strings.Join(path, "."))
+		}
+		o = m[k]
+	}
+	return o
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationKeyAdoptExisting controls whether a managed resource without an
+// external name may adopt an existing external resource of the same name
+// instead of creating a new one. Its value is either "true" or "false".
+const AnnotationKeyAdoptExisting = "upbound.io/adopt-existing"
+
+// ReasonConflict is the reason of the Ready condition of a managed resource
+// whose external resource already exists but may not be adopted.
+const ReasonConflict xpv1.ConditionReason = "Conflict"
+
+// AdoptionAllowed reports whether the supplied managed resource may adopt an
+// existing external resource by name. Adoption is allowed unless the
+// annotation opts out, or, if an annotation is required, only when it opts
+// in. A managed resource whose creation result is unknown may always adopt,
+// since the external resource is likely the one it created.
+func AdoptionAllowed(o metav1.Object, requireAnnotation bool) bool {
+	if meta.ExternalCreateIncomplete(o) {
+		return true
+	}
+	v, ok := o.GetAnnotations()[AnnotationKeyAdoptExisting]
+	if requireAnnotation {
+		return v == "true"
+	}
+	return !ok || v != "false"
+}
+
+// Conflict returns a condition indicating that the external resource of a
+// managed resource already exists and was not adopted.
+func Conflict(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonConflict,
+		Message:            msg,
+	}
+}
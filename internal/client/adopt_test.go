@@ -0,0 +1,68 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAdoptionAllowed(t *testing.T) {
+	pending := &metav1.ObjectMeta{}
+	meta.SetExternalCreatePending(pending, time.Now())
+
+	cases := map[string]struct {
+		o                 metav1.Object
+		requireAnnotation bool
+		want              bool
+	}{
+		"NoAnnotation": {
+			o:    &metav1.ObjectMeta{},
+			want: true,
+		},
+		"OptedOut": {
+			o:    &metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyAdoptExisting: "false"}},
+			want: false,
+		},
+		"AnnotationRequiredButMissing": {
+			o:                 &metav1.ObjectMeta{},
+			requireAnnotation: true,
+			want:              false,
+		},
+		"AnnotationRequiredAndOptedIn": {
+			o:                 &metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyAdoptExisting: "true"}},
+			requireAnnotation: true,
+			want:              true,
+		},
+		"CreationResultUnknown": {
+			o:                 pending,
+			requireAnnotation: true,
+			want:              true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := AdoptionAllowed(tc.o, tc.requireAnnotation); got != tc.want {
+				t.Errorf("AdoptionAllowed(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/configurations"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ctpcommonv1alpha1 "github.com/upbound/provider-upbound/apis/common/controlplane/v1alpha1"
+)
+
+// StatusFromResponse set status from response
+func StatusFromResponse(resp configurations.ConfigurationResponse) ctpcommonv1alpha1.ConfigurationObservation {
+	status := ctpcommonv1alpha1.ConfigurationObservation{
+		ID:            resp.ID.String(),
+		LatestVersion: resp.LatestVersion,
+		Branch:        resp.Branch,
+		CreatorID:     resp.CreatorID,
+		CreatedAt:     &metav1.Time{Time: resp.CreatedAt},
+	}
+	if resp.UpdatedAt != nil {
+		status.UpdatedAt = &metav1.Time{Time: *resp.UpdatedAt}
+	}
+	if resp.SyncedAt != nil {
+		status.SyncedAt = &metav1.Time{Time: *resp.SyncedAt}
+	}
+	return status
+}
+
+const basePath = "v1/configurations"
+
+// Response is a configuration with its description, which the configurations
+// client of the up-sdk-go does not return.
+type Response struct {
+	configurations.ConfigurationResponse
+
+	Description *string `json:"description,omitempty"`
+}
+
+// CreateParameters are the parameters of a new configuration with its
+// description, which the configurations client of the up-sdk-go cannot set.
+type CreateParameters struct {
+	configurations.ConfigurationCreateParameters
+
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateParameters are the updatable fields of a configuration.
+type UpdateParameters struct {
+	Description *string `json:"description,omitempty"`
+}
+
+// Client manages configurations including their descriptions.
+type Client struct {
+	*up.Config
+}
+
+// NewClient returns a new configurations client.
+func NewClient(cfg *up.Config) *Client {
+	return &Client{Config: cfg}
+}
+
+// Get returns the configuration with the supplied name in the supplied
+// account.
+func (c *Client) Get(ctx context.Context, account, name string) (*Response, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, fmt.Sprintf("%s/%s", account, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Response{}
+	if err := c.Client.Do(req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Create creates a configuration in the supplied account.
+func (c *Client) Create(ctx context.Context, account string, params *CreateParameters) (*Response, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, account, params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Response{}
+	if err := c.Client.Do(req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Update updates the configuration with the supplied name in the supplied
+// account.
+func (c *Client) Update(ctx context.Context, account, name string, params *UpdateParameters) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPatch, basePath, fmt.Sprintf("%s/%s", account, name), params)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+// Delete deletes the configuration with the supplied name in the supplied
+// account.
+func (c *Client) Delete(ctx context.Context, account, name string) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, fmt.Sprintf("%s/%s", account, name), nil)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/configurations"
+)
+
+func TestClient(t *testing.T) {
+	type request struct {
+		Method string
+		Path   string
+		Body   map[string]any
+	}
+	var got []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := request{Method: r.Method, Path: r.URL.Path}
+		if b, _ := io.ReadAll(r.Body); len(b) > 0 {
+			if err := json.Unmarshal(b, &req.Body); err != nil {
+				t.Errorf("cannot decode request body: %v", err)
+			}
+		}
+		got = append(got, req)
+		_, _ = w.Write([]byte(`{"name":"example","description":"described"}`))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	c := NewClient(up.NewConfig(func(cfg *up.Config) {
+		cfg.Client = up.NewClient(func(c *up.HTTPClient) { c.BaseURL = u })
+	}))
+	ctx := context.Background()
+
+	if _, err := c.Create(ctx, "acme", &CreateParameters{
+		ConfigurationCreateParameters: configurations.ConfigurationCreateParameters{Name: "example", Repo: "example"},
+		Description:                   ptr.To("described"),
+	}); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	resp, err := c.Get(ctx, "acme", "example")
+	if err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	if diff := cmp.Diff(ptr.To("described"), resp.Description); diff != "" {
+		t.Errorf("Get(...): -want description, +got description:\n%s", diff)
+	}
+	if err := c.Update(ctx, "acme", "example", &UpdateParameters{Description: ptr.To("updated")}); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+
+	want := []request{
+		{Method: http.MethodPost, Path: "/v1/configurations/acme", Body: map[string]any{
+			"name": "example", "repo": "example", "description": "described",
+			"context": "", "provider": "", "templateId": "", "private": false,
+		}},
+		{Method: http.MethodGet, Path: "/v1/configurations/acme/example"},
+		{Method: http.MethodPatch, Path: "/v1/configurations/acme/example", Body: map[string]any{"description": "updated"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("requests: -want, +got:\n%s", diff)
+	}
+}
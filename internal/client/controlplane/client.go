@@ -0,0 +1,97 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplane
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	up "github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	ctpcommonv1alpha1 "github.com/upbound/provider-upbound/apis/common/controlplane/v1alpha1"
+)
+
+const (
+	// ConnectionSecretKeyKubeconfig is the key of the kubeconfig in the
+	// connection secret of a ControlPlane.
+	ConnectionSecretKeyKubeconfig = "kubeconfig"
+)
+
+// StatusFromResponse set status from response
+func StatusFromResponse(resp controlplanes.ControlPlaneResponse) ctpcommonv1alpha1.ControlPlaneObservation {
+	status := ctpcommonv1alpha1.ControlPlaneObservation{
+		ID:         resp.ControlPlane.ID.String(),
+		Status:     string(resp.Status),
+		Permission: string(resp.Permission),
+		CreatorID:  resp.ControlPlane.CreatorID,
+	}
+	if resp.ControlPlane.CreatedAt != nil {
+		status.CreatedAt = &metav1.Time{Time: *resp.ControlPlane.CreatedAt}
+	}
+	if resp.ControlPlane.UpdatedAt != nil {
+		status.UpdatedAt = &metav1.Time{Time: *resp.ControlPlane.UpdatedAt}
+	}
+	if c := resp.ControlPlane.Configuration; c != nil {
+		status.Configuration = &ctpcommonv1alpha1.ControlPlaneConfigurationObservation{
+			ID:             c.ID.String(),
+			Name:           c.Name,
+			Status:         string(c.Status),
+			CurrentVersion: c.CurrentVersion,
+			DesiredVersion: c.DesiredVersion,
+		}
+		if c.SyncedAt != nil {
+			status.Configuration.SyncedAt = &metav1.Time{Time: *c.SyncedAt}
+		}
+		if c.DeployedAt != nil {
+			status.Configuration.DeployedAt = &metav1.Time{Time: *c.DeployedAt}
+		}
+	}
+	return status
+}
+
+// ProxyURL returns the URL of the Kubernetes API server of the supplied
+// control plane. The proxy is served from the host of the Upbound API with its
+// "api." prefix replaced by "proxy.".
+func ProxyURL(cfg *up.Config, account, name string) string {
+	base := &url.URL{Scheme: "https", Host: "api.upbound.io"}
+	if hc, ok := cfg.Client.(*up.HTTPClient); ok && hc.BaseURL != nil {
+		base = hc.BaseURL
+	}
+	u := url.URL{
+		Scheme: base.Scheme,
+		Host:   "proxy." + strings.TrimPrefix(base.Host, "api."),
+		Path:   path.Join("/v1/controlPlanes", account, name, "k8s"),
+	}
+	return u.String()
+}
+
+// Kubeconfig returns a kubeconfig for the supplied control plane. The
+// kubeconfig authenticates with the supplied token, if any.
+func Kubeconfig(cfg *up.Config, account, name string, token []byte) ([]byte, error) {
+	key := account + "-" + name
+	kc := clientcmdapi.NewConfig()
+	kc.Clusters[key] = &clientcmdapi.Cluster{Server: ProxyURL(cfg, account, name)}
+	kc.AuthInfos[key] = &clientcmdapi.AuthInfo{Token: string(token)}
+	kc.Contexts[key] = &clientcmdapi.Context{Cluster: key, AuthInfo: key}
+	kc.CurrentContext = key
+	return clientcmd.Write(*kc)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplane
+
+import (
+	"net/url"
+	"testing"
+
+	up "github.com/upbound/up-sdk-go"
+)
+
+func TestProxyURL(t *testing.T) {
+	cases := map[string]struct {
+		cfg  *up.Config
+		want string
+	}{
+		"Default": {
+			cfg:  &up.Config{},
+			want: "https://proxy.upbound.io/v1/controlPlanes/acme/example/k8s",
+		},
+		"CustomEndpoint": {
+			cfg: &up.Config{Client: &up.HTTPClient{
+				BaseURL: &url.URL{Scheme: "https", Host: "api.local.upbound.io"},
+			}},
+			want: "https://proxy.local.upbound.io/v1/controlPlanes/acme/example/k8s",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := ProxyURL(tc.cfg, "acme", "example"); got != tc.want {
+				t.Errorf("ProxyURL(...): want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplanepermission
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/upbound/up-sdk-go"
+)
+
+const (
+	basePathFmt = "/v1/controlPlanePermissions/%s/teams/%s"
+)
+
+// NewClient returns a client for the permissions of teams on control planes.
+func NewClient(cfg *up.Config) *Client {
+	return &Client{
+		Config: cfg,
+	}
+}
+
+// Client manages the permissions of teams on control planes.
+type Client struct {
+	*up.Config
+}
+
+// Get returns the permission of a team on a control plane.
+func (c *Client) Get(ctx context.Context, params *GetParameters) (*Permission, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(basePathFmt, params.Organization, params.TeamID), params.ControlPlane, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Permission{}
+	if err := c.Client.Do(req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Set grants a team a permission on a control plane, replacing any permission
+// it previously had.
+func (c *Client) Set(ctx context.Context, params *SetParameters) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPut, fmt.Sprintf(basePathFmt, params.Organization, params.TeamID), params.ControlPlane, &Permission{
+		Permission: params.Permission,
+	})
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+// Delete revokes the permission of a team on a control plane.
+func (c *Client) Delete(ctx context.Context, params *GetParameters) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf(basePathFmt, params.Organization, params.TeamID), params.ControlPlane, nil)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
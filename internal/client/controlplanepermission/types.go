@@ -0,0 +1,38 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplanepermission
+
+// GetParameters identify the permission of a team on a control plane.
+type GetParameters struct {
+	ControlPlane string `json:"controlPlane"`
+	Organization string `json:"organization"`
+	TeamID       string `json:"teamId"`
+}
+
+// SetParameters are the parameters to grant a team a permission on a control
+// plane.
+type SetParameters struct {
+	ControlPlane string `json:"controlPlane"`
+	Organization string `json:"organization"`
+	TeamID       string `json:"teamId"`
+	Permission   string `json:"permission"`
+}
+
+// Permission is the permission of a team on a control plane.
+type Permission struct {
+	Permission string `json:"permission"`
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/upbound/up-sdk-go/service/common"
+)
+
+// LateInitialize sets the supplied optional field to the supplied value
+// reported by the Upbound API if the field is unset and the value is not the
+// zero value. It returns true if the field was set.
+func LateInitialize[T comparable](field **T, value T) bool {
+	var zero T
+	if *field != nil || value == zero {
+		return false
+	}
+	*field = &value
+	return true
+}
+
+// LateInitializeString sets the supplied required string field to the
+// supplied value reported by the Upbound API if the field is empty. It returns
+// true if the field was set.
+func LateInitializeString(field *string, value string) bool {
+	if *field != "" || value == "" {
+		return false
+	}
+	*field = value
+	return true
+}
+
+// RelationshipID returns the ID of the resource the supplied JSON API
+// relationship refers to, e.g. the owner of a robot or token, or an empty
+// string if there is no such relationship.
+func RelationshipID(rs common.RelationshipSet, name string) string {
+	r, ok := rs[name].(map[string]any)
+	if !ok {
+		return ""
+	}
+	data, ok := r["data"].(map[string]any)
+	if !ok || data["id"] == nil {
+		return ""
+	}
+	// IDs of organizations and users are numbers.
+	switch id := data["id"].(type) {
+	case string:
+		return id
+	case float64:
+		return fmt.Sprintf("%d", int64(id))
+	default:
+		return fmt.Sprint(id)
+	}
+}
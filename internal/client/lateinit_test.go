@@ -0,0 +1,95 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	"github.com/upbound/up-sdk-go/service/common"
+)
+
+func TestLateInitialize(t *testing.T) {
+	type want struct {
+		field   *string
+		changed bool
+	}
+
+	cases := map[string]struct {
+		field *string
+		value string
+		want  want
+	}{
+		"Unset": {
+			value: "observed",
+			want:  want{field: ptr.To("observed"), changed: true},
+		},
+		"Set": {
+			field: ptr.To("desired"),
+			value: "observed",
+			want:  want{field: ptr.To("desired")},
+		},
+		"ZeroValue": {
+			value: "",
+			want:  want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			field := tc.field
+			changed := LateInitialize(&field, tc.value)
+			if diff := cmp.Diff(tc.want, want{field: field, changed: changed}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRelationshipID(t *testing.T) {
+	cases := map[string]struct {
+		rs   common.RelationshipSet
+		want string
+	}{
+		"UUID": {
+			rs:   common.RelationshipSet{"owner": map[string]any{"data": map[string]any{"type": "robots", "id": "c0ffee"}}},
+			want: "c0ffee",
+		},
+		"Number": {
+			rs:   common.RelationshipSet{"owner": map[string]any{"data": map[string]any{"type": "organization", "id": float64(1234)}}},
+			want: "1234",
+		},
+		"Missing": {
+			rs:   common.RelationshipSet{},
+			want: "",
+		},
+		"NoData": {
+			rs:   common.RelationshipSet{"owner": map[string]any{"data": nil}},
+			want: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, RelationshipID(tc.rs, "owner")); diff != "" {
+				t.Errorf("RelationshipID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/organizations"
+)
+
+const (
+	basePath = "v1/organizations"
+)
+
+// NewClient returns a client for organizations.
+func NewClient(cfg *up.Config) *Client {
+	return &Client{
+		Config: cfg,
+	}
+}
+
+// Client reads organizations, including fields the up-sdk organizations
+// client does not decode.
+type Client struct {
+	*up.Config
+}
+
+// Organization is an organization on Upbound.
+type Organization struct {
+	organizations.Organization `json:",inline"`
+
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+// Get an organization by ID.
+func (c *Client) Get(ctx context.Context, id uint) (*Organization, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, strconv.FormatUint(uint64(id), 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	org := &Organization{}
+	if err := c.Client.Do(req, org); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
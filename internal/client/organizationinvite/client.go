@@ -0,0 +1,69 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationinvite
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/upbound/up-sdk-go"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/organizations"
+)
+
+func NewClient(cfg *up.Config) *Client {
+	return &Client{
+		Client: organizations.NewClient(cfg),
+	}
+}
+
+// A Client manages the invites of organizations. It adds lookups by email to
+// the organizations client, which identifies invites and members by ID.
+type Client struct {
+	*organizations.Client
+}
+
+// Get returns the pending invite for the supplied email in the organization
+// with the supplied ID. It returns a not found error if there is none.
+func (c *Client) Get(ctx context.Context, orgId uint, email string) (*Invite, error) {
+	invites, err := c.ListInvites(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range invites {
+		if strings.EqualFold(invites[i].Email, email) {
+			return &invites[i], nil
+		}
+	}
+	return nil, &uperrors.Error{Status: http.StatusNotFound}
+}
+
+// GetMember returns the member of the organization with the supplied ID that
+// has the supplied email. It returns a not found error if there is none.
+func (c *Client) GetMember(ctx context.Context, orgId uint, email string) (*Member, error) {
+	members, err := c.ListMembers(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if strings.EqualFold(members[i].User.Email, email) {
+			return &members[i], nil
+		}
+	}
+	return nil, &uperrors.Error{Status: http.StatusNotFound}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationmember
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/upbound/up-sdk-go"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/organizations"
+)
+
+const (
+	basePath = "v1/organizations"
+)
+
+func NewClient(cfg *up.Config) *Client {
+	return &Client{
+		Config:        cfg,
+		organizations: organizations.NewClient(cfg),
+	}
+}
+
+type Client struct {
+	*up.Config
+	organizations *organizations.Client
+}
+
+// Get returns the member of the organization with the supplied ID that has
+// the supplied username. It returns a not found error if there is none.
+func (c *Client) Get(ctx context.Context, orgId uint, username string) (*organizations.Member, error) {
+	members, err := c.organizations.ListMembers(ctx, orgId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if members[i].User.Username == username {
+			return &members[i], nil
+		}
+	}
+	return nil, &uperrors.Error{Status: http.StatusNotFound}
+}
+
+func (c *Client) Update(ctx context.Context, orgId uint, userId uint, params *UpdateParameters) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPut, basePath, fmt.Sprintf("%d/members/%d", orgId, userId), params)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+func (c *Client) Delete(ctx context.Context, orgId uint, userId uint) error {
+	return c.organizations.RemoveMember(ctx, orgId, userId)
+}
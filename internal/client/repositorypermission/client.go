@@ -63,6 +63,20 @@ func (c *Client) Create(ctx context.Context, params *CreateParameters) error {
 	return nil
 }
 
+// List returns the permissions of a team on all repositories of an
+// organization.
+func (c *Client) List(ctx context.Context, params *ListParameters) ([]Grant, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, fmt.Sprintf(basePathFmt, params.Organization, params.TeamID), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &listResponse{}
+	if err := c.Client.Do(req, resp); err != nil {
+		return nil, err
+	}
+	return resp.Permissions, nil
+}
+
 func (c *Client) Delete(ctx context.Context, params *GetParameters) error {
 	req, err := c.Client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf(basePathFmt, params.Organization, params.TeamID), params.Repository, nil)
 	if err != nil {
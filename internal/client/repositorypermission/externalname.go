@@ -0,0 +1,40 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositorypermission
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ExternalName returns the external name of the permission of the team with
+// the supplied ID on the supplied repository of the supplied organization,
+// which is of the form <organization>/<repository>/<teamID>.
+func ExternalName(organization, repository, teamID string) string {
+	return strings.Join([]string{organization, repository, teamID}, "/")
+}
+
+// ParseExternalName returns the organization, repository and team ID of the
+// supplied external name of the form <organization>/<repository>/<teamID>.
+func ParseExternalName(name string) (organization, repository, teamID string, err error) {
+	p := strings.Split(name, "/")
+	if len(p) != 3 || p[0] == "" || p[1] == "" || p[2] == "" {
+		return "", "", "", errors.Errorf("external name %q is not of the form <organization>/<repository>/<teamID>", name)
+	}
+	return p[0], p[1], p[2], nil
+}
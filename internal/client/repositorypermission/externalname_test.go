@@ -0,0 +1,74 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositorypermission
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseExternalName(t *testing.T) {
+	type want struct {
+		organization string
+		repository   string
+		teamID       string
+		err          bool
+	}
+
+	cases := map[string]struct {
+		name string
+		want want
+	}{
+		"Valid": {
+			name: "upbound/platform/c0ffee",
+			want: want{organization: "upbound", repository: "platform", teamID: "c0ffee"},
+		},
+		"LegacyRepositoryName": {
+			name: "platform",
+			want: want{err: true},
+		},
+		"EmptyPart": {
+			name: "upbound//c0ffee",
+			want: want{err: true},
+		},
+		"TooManyParts": {
+			name: "upbound/platform/c0ffee/extra",
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			org, repo, team, err := ParseExternalName(tc.name)
+			got := want{organization: org, repository: repo, teamID: team, err: err != nil}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("ParseExternalName(%q): -want, +got:\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestExternalNameRoundTrip(t *testing.T) {
+	org, repo, team, err := ParseExternalName(ExternalName("upbound", "platform", "c0ffee"))
+	if err != nil {
+		t.Fatalf("ParseExternalName(ExternalName(...)): %v", err)
+	}
+	if diff := cmp.Diff([]string{"upbound", "platform", "c0ffee"}, []string{org, repo, team}); diff != "" {
+		t.Errorf("ParseExternalName(ExternalName(...)): -want, +got:\n%s", diff)
+	}
+}
@@ -32,3 +32,19 @@ type GetParameters struct {
 type SetPermission struct {
 	Permission string `json:"permission"`
 }
+
+type ListParameters struct {
+	Organization string `json:"organization"`
+	TeamID       string `json:"TeamId"`
+}
+
+// Grant is a permission of a team on a repository.
+type Grant struct {
+	TeamID         string `json:"teamId"`
+	RepositoryName string `json:"repositoryName"`
+	Privilege      string `json:"privilege"`
+}
+
+type listResponse struct {
+	Permissions []Grant `json:"permissions"`
+}
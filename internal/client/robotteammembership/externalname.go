@@ -0,0 +1,40 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package robotteammembership
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ExternalName returns the external name of the membership of the robot with
+// the supplied ID in the team with the supplied ID, which is of the form
+// <robotID>/<teamID>.
+func ExternalName(robotID, teamID string) string {
+	return robotID + "/" + teamID
+}
+
+// ParseExternalName returns the robot and team IDs of the supplied external
+// name of the form <robotID>/<teamID>.
+func ParseExternalName(name string) (robotID, teamID string, err error) {
+	p := strings.Split(name, "/")
+	if len(p) != 2 || p[0] == "" || p[1] == "" {
+		return "", "", errors.Errorf("external name %q is not of the form <robotID>/<teamID>", name)
+	}
+	return p[0], p[1], nil
+}
@@ -19,12 +19,15 @@ package teams
 import (
 	"context"
 	"net/http"
+	"path"
+	"strconv"
 
 	"github.com/upbound/up-sdk-go"
 )
 
 const (
-	basePath = "v1/teams"
+	basePath              = "v1/teams"
+	organizationsBasePath = "v1/organizations"
 )
 
 func NewClient(cfg *up.Config) *Client {
@@ -68,3 +71,17 @@ func (c *Client) Delete(ctx context.Context, id string) error {
 	}
 	return c.Client.Do(req, nil)
 }
+
+// List returns all teams the caller can access in the organization with the
+// supplied ID.
+func (c *Client) List(ctx context.Context, orgID uint) ([]Team, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, organizationsBasePath, path.Join(strconv.FormatUint(uint64(orgID), 10), "teams"), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := []Team{}
+	if err := c.Client.Do(req, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
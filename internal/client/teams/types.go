@@ -16,7 +16,11 @@ limitations under the License.
 
 package teams
 
-import "github.com/upbound/up-sdk-go/service/common"
+import (
+	"time"
+
+	"github.com/upbound/up-sdk-go/service/common"
+)
 
 type GetResponse struct {
 	common.DataSet `json:"data"`
@@ -30,3 +34,9 @@ type CreateParameters struct {
 type CreateResponse struct {
 	ID string `json:"id"`
 }
+
+type Team struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2026 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package token
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/up-sdk-go/service/tokens"
+
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+)
+
+// Keys of the formatted credentials.
+const (
+	KeyToken     = "token"
+	KeyID        = "id"
+	KeyUpProfile = "config.json"
+
+	// upProfileName is the name of the profile in the written up CLI
+	// configuration.
+	upProfileName = "default"
+)
+
+// Credentials of an issued token.
+type Credentials struct {
+	// ID of the token. It is also the access ID used as username when
+	// authenticating with the token.
+	ID string
+
+	// Token is the JWT of the token.
+	Token string
+}
+
+// CredentialsFrom returns the credentials of a newly issued token. The JWT is
+// only returned by the API when the token is created.
+func CredentialsFrom(resp *tokens.TokenResponse) Credentials {
+	return Credentials{
+		ID:    resp.ID.String(),
+		Token: fmt.Sprint(resp.DataSet.Meta["jwt"]),
+	}
+}
+
+// CredentialsFromData returns the credentials found in data written in any of
+// the formats.
+func CredentialsFromData(data map[string][]byte) (Credentials, bool) {
+	c := Credentials{ID: string(data[KeyID]), Token: string(data[KeyToken])}
+	return c, c.ID != "" && c.Token != ""
+}
+
+// A Profiler returns an up CLI profile that is logged in with the supplied
+// credentials.
+type Profiler func(ctx context.Context, c Credentials) (upclient.Profile, error)
+
+// NewProfiler returns a Profiler that logs in to the API endpoint of the
+// ProviderConfig returned by the supplied function.
+func NewProfiler(kube client.Client, getPCFn upclient.GetProviderConfigSpecFn) Profiler {
+	return func(ctx context.Context, c Credentials) (upclient.Profile, error) {
+		pc, err := getPCFn(ctx, kube)
+		if err != nil {
+			return upclient.Profile{}, errors.Wrap(err, "cannot get provider config")
+		}
+		return upclient.TokenProfile(ctx, pc, c.Token)
+	}
+}
+
+// Render returns the supplied credentials in the supplied format. The profiler
+// is only used by the UpProfile format, and may be nil otherwise.
+func Render(ctx context.Context, f iamv1alpha1common.TokenFormat, c Credentials, p Profiler) (map[string][]byte, error) {
+	data := map[string][]byte{
+		KeyToken: []byte(c.Token),
+		KeyID:    []byte(c.ID),
+	}
+	switch f.Format {
+	case iamv1alpha1common.TokenOutputFormatRaw, "":
+	case iamv1alpha1common.TokenOutputFormatDockerConfigJSON:
+		registry := ptr.Deref(f.Registry, iamv1alpha1common.DefaultTokenOutputRegistry)
+		b, err := json.Marshal(dockerConfig{Auths: map[string]dockerAuth{
+			registry: {
+				Username: c.ID,
+				Password: c.Token,
+				Auth:     base64.StdEncoding.EncodeToString([]byte(c.ID + ":" + c.Token)),
+			},
+		}})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal docker config")
+		}
+		data[corev1.DockerConfigJsonKey] = b
+	case iamv1alpha1common.TokenOutputFormatUpProfile:
+		if p == nil {
+			return nil, errors.New("cannot write an up CLI profile without a profiler")
+		}
+		profile, err := p(ctx, c)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot log in with the token")
+		}
+		b, err := json.Marshal(upclient.CLIConfig{Upbound: upclient.Upbound{
+			Default:  upProfileName,
+			Profiles: map[string]upclient.Profile{upProfileName: profile},
+		}})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal up CLI configuration")
+		}
+		data[KeyUpProfile] = b
+	default:
+		return nil, errors.Errorf("unknown token output format %q", f.Format)
+	}
+	return data, nil
+}
+
+// ConnectionDetails returns the connection details of the supplied
+// credentials in the supplied format.
+func ConnectionDetails(ctx context.Context, f iamv1alpha1common.TokenFormat, c Credentials, p Profiler) (managed.ConnectionDetails, error) {
+	data, err := Render(ctx, f, c, p)
+	return managed.ConnectionDetails(data), err
+}
+
+// SecretType returns the type of a Secret that holds credentials in the
+// supplied format.
+func SecretType(f iamv1alpha1common.TokenFormat) corev1.SecretType {
+	if f.Format == iamv1alpha1common.TokenOutputFormatDockerConfigJSON {
+		return corev1.SecretTypeDockerConfigJson
+	}
+	return corev1.SecretTypeOpaque
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Auth     string `json:"auth"`
+}
+
+// A SecretPublisher writes formatted token credentials to Secrets whose type
+// matches the format, which the connection secret publisher of the managed
+// reconciler cannot do.
+type SecretPublisher struct {
+	kube   client.Client
+	labels map[string]string
+}
+
+// A SecretPublisherOption configures a SecretPublisher.
+type SecretPublisherOption func(*SecretPublisher)
+
+// WithSecretLabels configures the labels written to every published Secret,
+// e.g. to find the Secrets of an owner later.
+func WithSecretLabels(l map[string]string) SecretPublisherOption {
+	return func(p *SecretPublisher) {
+		p.labels = l
+	}
+}
+
+// NewSecretPublisher returns a new SecretPublisher.
+func NewSecretPublisher(kube client.Client, o ...SecretPublisherOption) *SecretPublisher {
+	p := &SecretPublisher{kube: kube}
+	for _, fn := range o {
+		fn(p)
+	}
+	return p
+}
+
+// Lookup returns the credentials of the token with the supplied ID from the
+// first of the supplied Secrets that holds them.
+func (p *SecretPublisher) Lookup(ctx context.Context, id string, refs ...types.NamespacedName) (Credentials, bool, error) {
+	for _, ref := range refs {
+		s := &corev1.Secret{}
+		err := p.kube.Get(ctx, ref, s)
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return Credentials{}, false, errors.Wrapf(err, "cannot get secret %s", ref)
+		}
+		if c, ok := CredentialsFromData(s.Data); ok && c.ID == id {
+			return c, true, nil
+		}
+	}
+	return Credentials{}, false, nil
+}
+
+// Publish writes the supplied credentials in the supplied format to the
+// referenced Secret, which is controlled by the supplied owner of the supplied
+// kind.
+func (p *SecretPublisher) Publish(ctx context.Context, owner client.Object, kind schema.GroupVersionKind, ref types.NamespacedName, f iamv1alpha1common.TokenFormat, c Credentials, pr Profiler) error {
+	data, err := Render(ctx, f, c, pr)
+	if err != nil {
+		return err
+	}
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ref.Name,
+			Namespace:       ref.Namespace,
+			Labels:          p.labels,
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(owner, kind))},
+		},
+		Type: SecretType(f),
+		Data: data,
+	}
+
+	current := &corev1.Secret{}
+	err = p.kube.Get(ctx, ref, current)
+	if kerrors.IsNotFound(err) {
+		return errors.Wrapf(p.kube.Create(ctx, desired), "cannot create secret %s", ref)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "cannot get secret %s", ref)
+	}
+	if o := metav1.GetControllerOf(current); o == nil || o.UID != owner.GetUID() {
+		return errors.Errorf("existing secret %s is not controlled by %s %s", ref, kind.Kind, owner.GetName())
+	}
+	if current.Type != desired.Type {
+		// The type of a Secret is immutable, so it has to be recreated when
+		// the format changes.
+		if err := p.kube.Delete(ctx, current); err != nil && !kerrors.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot delete secret %s", ref)
+		}
+		return errors.Wrapf(p.kube.Create(ctx, desired), "cannot create secret %s", ref)
+	}
+	if maps.EqualFunc(current.Data, desired.Data, bytes.Equal) && hasLabels(current, p.labels) {
+		return nil
+	}
+	current.Data = desired.Data
+	meta.AddLabels(current, p.labels)
+	return errors.Wrapf(p.kube.Update(ctx, current), "cannot update secret %s", ref)
+}
+
+// hasLabels reports whether the supplied object has all supplied labels.
+func hasLabels(o metav1.Object, l map[string]string) bool {
+	for k, v := range l {
+		if o.GetLabels()[k] != v {
+			return false
+		}
+	}
+	return true
+}
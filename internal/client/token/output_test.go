@@ -0,0 +1,108 @@
+/*
+Copyright 2026 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package token
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+)
+
+func TestRender(t *testing.T) {
+	creds := Credentials{ID: "access-id", Token: "jwt"}
+	errBoom := errors.New("boom")
+	profiler := func(_ context.Context, c Credentials) (upclient.Profile, error) {
+		return upclient.Profile{ID: c.ID, Type: upclient.TokenProfileType, Session: "session", Account: "acme"}, nil
+	}
+
+	cases := map[string]struct {
+		f    iamv1alpha1common.TokenFormat
+		p    Profiler
+		want map[string]string
+		err  error
+	}{
+		"Raw": {
+			f: iamv1alpha1common.TokenFormat{Format: iamv1alpha1common.TokenOutputFormatRaw},
+			want: map[string]string{
+				"token": "jwt",
+				"id":    "access-id",
+			},
+		},
+		"DockerConfigJSONDefaultRegistry": {
+			f: iamv1alpha1common.TokenFormat{Format: iamv1alpha1common.TokenOutputFormatDockerConfigJSON},
+			want: map[string]string{
+				"token":             "jwt",
+				"id":                "access-id",
+				".dockerconfigjson": `{"auths":{"xpkg.upbound.io":{"username":"access-id","password":"jwt","auth":"YWNjZXNzLWlkOmp3dA=="}}}`,
+			},
+		},
+		"DockerConfigJSONCustomRegistry": {
+			f: iamv1alpha1common.TokenFormat{Format: iamv1alpha1common.TokenOutputFormatDockerConfigJSON, Registry: ptr.To("registry.example.com")},
+			want: map[string]string{
+				"token":             "jwt",
+				"id":                "access-id",
+				".dockerconfigjson": `{"auths":{"registry.example.com":{"username":"access-id","password":"jwt","auth":"YWNjZXNzLWlkOmp3dA=="}}}`,
+			},
+		},
+		"UpProfile": {
+			f: iamv1alpha1common.TokenFormat{Format: iamv1alpha1common.TokenOutputFormatUpProfile},
+			p: profiler,
+			want: map[string]string{
+				"token":       "jwt",
+				"id":          "access-id",
+				"config.json": `{"upbound":{"default":"default","profiles":{"default":{"id":"access-id","type":"token","session":"session","account":"acme"}}}}`,
+			},
+		},
+		"UpProfileLoginFailed": {
+			f: iamv1alpha1common.TokenFormat{Format: iamv1alpha1common.TokenOutputFormatUpProfile},
+			p: func(context.Context, Credentials) (upclient.Profile, error) {
+				return upclient.Profile{}, errBoom
+			},
+			err: errors.Wrap(errBoom, "cannot log in with the token"),
+		},
+		"UpProfileWithoutProfiler": {
+			f:   iamv1alpha1common.TokenFormat{Format: iamv1alpha1common.TokenOutputFormatUpProfile},
+			err: errors.New("cannot write an up CLI profile without a profiler"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := Render(context.Background(), tc.f, creds, tc.p)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("Render(...): -want error, +got error:\n%s", diff)
+			}
+			if tc.err != nil {
+				return
+			}
+			got := map[string]string{}
+			for k, v := range data {
+				got[k] = string(v)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Render(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package token
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/up-sdk-go/service/tokens"
+
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+)
+
+// DefaultRotationOverlap is how long the previous token stays valid after a
+// rotation when no overlap is configured.
+const DefaultRotationOverlap = 24 * time.Hour
+
+// The previous token is recorded in annotations that are written together
+// with the external name of the current token. Status may not be persisted
+// after a rotation, and the previous token would be leaked if it was only
+// recorded there.
+const (
+	AnnotationKeyPreviousTokenID        = "upbound.io/previous-token-id"
+	AnnotationKeyPreviousTokenExpiresAt = "upbound.io/previous-token-expires-at"
+)
+
+// IssuedAt returns the time the token was issued as reported by the API.
+func IssuedAt(resp *tokens.TokenResponse) (time.Time, bool) {
+	s, ok := resp.AttributeSet["createdAt"].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Overlap returns the overlap window of the supplied rotation.
+func Overlap(r *iamv1alpha1common.TokenRotation) time.Duration {
+	if r == nil || r.Overlap == nil {
+		return DefaultRotationOverlap
+	}
+	return r.Overlap.Duration
+}
+
+// UpdateObservation records the observed token as the current token. The
+// fallback time is used as its issue time if the API does not report one.
+func UpdateObservation(obs *iamv1alpha1common.TokenObservation, r *iamv1alpha1common.TokenRotation, resp *tokens.TokenResponse, fallback time.Time) {
+	if obs.CurrentTokenID != resp.ID.String() || obs.CurrentTokenIssuedAt == nil {
+		issued := fallback
+		if t, ok := IssuedAt(resp); ok {
+			issued = t
+		}
+		obs.CurrentTokenID = resp.ID.String()
+		obs.CurrentTokenIssuedAt = &metav1.Time{Time: issued}
+	}
+	obs.NextRotationAt = nil
+	if r != nil && r.Every.Duration > 0 {
+		obs.NextRotationAt = &metav1.Time{Time: obs.CurrentTokenIssuedAt.Add(r.Every.Duration)}
+	}
+}
+
+// RecordRotation records that the current token has been replaced by the
+// newly issued one at the supplied time.
+func RecordRotation(obs *iamv1alpha1common.TokenObservation, r *iamv1alpha1common.TokenRotation, resp *tokens.TokenResponse, now time.Time) {
+	obs.PreviousTokenID = obs.CurrentTokenID
+	obs.PreviousTokenExpiresAt = &metav1.Time{Time: now.Add(Overlap(r))}
+	obs.CurrentTokenID = ""
+	UpdateObservation(obs, r, resp, now)
+}
+
+// ClearPrevious forgets the previous token after it has been deleted.
+func ClearPrevious(obs *iamv1alpha1common.TokenObservation) {
+	obs.PreviousTokenID = ""
+	obs.PreviousTokenExpiresAt = nil
+}
+
+// RotationDue reports whether the current token must be rotated at the
+// supplied time.
+func RotationDue(obs iamv1alpha1common.TokenObservation, now time.Time) bool {
+	return obs.NextRotationAt != nil && !now.Before(obs.NextRotationAt.Time)
+}
+
+// PreviousExpired reports whether the previous token has outlived its
+// overlap window at the supplied time and must be deleted.
+func PreviousExpired(obs iamv1alpha1common.TokenObservation, now time.Time) bool {
+	if obs.PreviousTokenID == "" {
+		return false
+	}
+	return obs.PreviousTokenExpiresAt == nil || !now.Before(obs.PreviousTokenExpiresAt.Time)
+}
+
+// SetPreviousAnnotations records the previous token of the supplied
+// observation in the annotations of the supplied object, or removes them if
+// there is none. It reports whether the annotations changed.
+func SetPreviousAnnotations(o metav1.Object, obs iamv1alpha1common.TokenObservation) bool {
+	a := o.GetAnnotations()
+	want := map[string]string{}
+	if obs.PreviousTokenID != "" {
+		want[AnnotationKeyPreviousTokenID] = obs.PreviousTokenID
+		if obs.PreviousTokenExpiresAt != nil {
+			want[AnnotationKeyPreviousTokenExpiresAt] = obs.PreviousTokenExpiresAt.UTC().Format(time.RFC3339)
+		}
+	}
+	changed := false
+	for _, k := range []string{AnnotationKeyPreviousTokenID, AnnotationKeyPreviousTokenExpiresAt} {
+		v, ok := want[k]
+		if cur, has := a[k]; has == ok && cur == v {
+			continue
+		}
+		changed = true
+		if !ok {
+			delete(a, k)
+			continue
+		}
+		if a == nil {
+			a = map[string]string{}
+		}
+		a[k] = v
+	}
+	o.SetAnnotations(a)
+	return changed
+}
+
+// RestorePrevious records the previous token found in the annotations of the
+// supplied object in the supplied observation. The observation is left as is
+// if the object has no such annotations.
+func RestorePrevious(obs *iamv1alpha1common.TokenObservation, o metav1.Object) {
+	id := o.GetAnnotations()[AnnotationKeyPreviousTokenID]
+	if id == "" {
+		return
+	}
+	obs.PreviousTokenID = id
+	obs.PreviousTokenExpiresAt = nil
+	if t, err := time.Parse(time.RFC3339, o.GetAnnotations()[AnnotationKeyPreviousTokenExpiresAt]); err == nil {
+		obs.PreviousTokenExpiresAt = &metav1.Time{Time: t}
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/tokens"
+
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+)
+
+func TestRotation(t *testing.T) {
+	issued := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := uuid.MustParse("8e4e5b4c-5a0f-4c3e-9a57-3a1b1d0b1f01")
+	next := uuid.MustParse("8e4e5b4c-5a0f-4c3e-9a57-3a1b1d0b1f02")
+	rotation := &iamv1alpha1common.TokenRotation{
+		Every:   metav1.Duration{Duration: 30 * 24 * time.Hour},
+		Overlap: &metav1.Duration{Duration: time.Hour},
+	}
+
+	obs := iamv1alpha1common.TokenObservation{}
+	UpdateObservation(&obs, rotation, &tokens.TokenResponse{DataSet: common.DataSet{
+		ID:           current,
+		AttributeSet: common.AttributeSet{"createdAt": issued.Format(time.RFC3339)},
+	}}, time.Now())
+
+	want := iamv1alpha1common.TokenObservation{
+		CurrentTokenID:       current.String(),
+		CurrentTokenIssuedAt: &metav1.Time{Time: issued},
+		NextRotationAt:       &metav1.Time{Time: issued.Add(30 * 24 * time.Hour)},
+	}
+	if diff := cmp.Diff(want, obs); diff != "" {
+		t.Errorf("UpdateObservation(...): -want, +got:\n%s", diff)
+	}
+	if RotationDue(obs, issued.Add(29*24*time.Hour)) {
+		t.Errorf("RotationDue(...): rotation must not be due before the interval has passed")
+	}
+
+	now := issued.Add(30 * 24 * time.Hour)
+	if !RotationDue(obs, now) {
+		t.Errorf("RotationDue(...): rotation must be due once the interval has passed")
+	}
+
+	RecordRotation(&obs, rotation, &tokens.TokenResponse{DataSet: common.DataSet{ID: next}}, now)
+	want = iamv1alpha1common.TokenObservation{
+		CurrentTokenID:         next.String(),
+		CurrentTokenIssuedAt:   &metav1.Time{Time: now},
+		NextRotationAt:         &metav1.Time{Time: now.Add(30 * 24 * time.Hour)},
+		PreviousTokenID:        current.String(),
+		PreviousTokenExpiresAt: &metav1.Time{Time: now.Add(time.Hour)},
+	}
+	if diff := cmp.Diff(want, obs); diff != "" {
+		t.Errorf("RecordRotation(...): -want, +got:\n%s", diff)
+	}
+	if PreviousExpired(obs, now.Add(30*time.Minute)) {
+		t.Errorf("PreviousExpired(...): previous token must be kept during the overlap window")
+	}
+	if !PreviousExpired(obs, now.Add(time.Hour)) {
+		t.Errorf("PreviousExpired(...): previous token must expire after the overlap window")
+	}
+}
+
+func TestPreviousAnnotations(t *testing.T) {
+	expires := time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)
+	rotated := iamv1alpha1common.TokenObservation{
+		PreviousTokenID:        "8e4e5b4c-5a0f-4c3e-9a57-3a1b1d0b1f01",
+		PreviousTokenExpiresAt: &metav1.Time{Time: expires},
+	}
+
+	o := &metav1.ObjectMeta{Annotations: map[string]string{"other": "kept"}}
+	if !SetPreviousAnnotations(o, rotated) {
+		t.Errorf("SetPreviousAnnotations(...): must report a change when a previous token is recorded")
+	}
+	if SetPreviousAnnotations(o, rotated) {
+		t.Errorf("SetPreviousAnnotations(...): must not report a change when the annotations are up to date")
+	}
+
+	// Status is lost, but the previous token is restored from annotations.
+	got := iamv1alpha1common.TokenObservation{}
+	RestorePrevious(&got, o)
+	if diff := cmp.Diff(rotated, got); diff != "" {
+		t.Errorf("RestorePrevious(...): -want, +got:\n%s", diff)
+	}
+
+	ClearPrevious(&got)
+	if !SetPreviousAnnotations(o, got) {
+		t.Errorf("SetPreviousAnnotations(...): must report a change when the previous token is removed")
+	}
+	if diff := cmp.Diff(map[string]string{"other": "kept"}, o.GetAnnotations()); diff != "" {
+		t.Errorf("SetPreviousAnnotations(...): -want, +got:\n%s", diff)
+	}
+
+	// Without annotations the observation is left as is.
+	RestorePrevious(&rotated, o)
+	if rotated.PreviousTokenID == "" {
+		t.Errorf("RestorePrevious(...): must not clear the observation without annotations")
+	}
+}
@@ -19,6 +19,8 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
 	"net/http"
 	"net/http/cookiejar"
@@ -56,8 +58,11 @@ const (
 
 var (
 	DefaultAPIEndpoint, _ = url.Parse("https://api.upbound.io")
-	profileMemory         = Profile{}
-	mu                    sync.Mutex
+	// profiles are the logged in profiles by the API endpoint and the
+	// credentials they logged in with, so that ProviderConfigs only share
+	// sessions when they share credentials.
+	profiles = map[string]Profile{}
+	mu       sync.Mutex
 )
 
 // GetProviderConfigSpecFn returns the referenced ProviderConfig's spec from a
@@ -87,21 +92,48 @@ func NewConfig(ctx context.Context, kube client.Client, getPCFn GetProviderConfi
 
 	cl := createUpClient(apiEndpoint, profile.Session)
 
+	// The session is shared by all ProviderConfigs with the same credentials,
+	// but the organization is that of the supplied one.
+	p := *profile
+	p.Account = pcSpec.Organization
+
 	return up.NewConfig(func(conf *up.Config) {
 		conf.Client = cl
-	}), *profile, nil
+	}), p, nil
+}
+
+// TokenProfile returns a profile that is logged in with the supplied token to
+// the API endpoint of the supplied ProviderConfig. Its session is cached like
+// those of ProviderConfigs, and replaced when it is about to expire.
+func TokenProfile(ctx context.Context, pcSpec *pcv1alpha1common.ProviderConfigSpec, token string) (Profile, error) {
+	p, err := createOrUpdateProfile(ctx, []byte(token), pcSpec)
+	if err != nil && err.Error() == errSessionTokenExpired {
+		// The expired session was evicted from the cache, so this logs in
+		// again.
+		p, err = createOrUpdateProfile(ctx, []byte(token), pcSpec)
+	}
+	if err != nil {
+		return Profile{}, err
+	}
+	return *p, nil
 }
 
 func createOrUpdateProfile(ctx context.Context, data []byte, pcSpec *pcv1alpha1common.ProviderConfigSpec) (*Profile, error) { //nolint:gocyclo
+	ep, err := getAPIEndpoint(pcSpec)
+	if err != nil {
+		return nil, errors.Wrap(err, errInvalidAPIEndpoint)
+	}
+
 	// use this shared to avoid get new session-token for each reconcile
 	mu.Lock()
 	defer mu.Unlock()
 
-	if profileMemory.Session != "" {
-		// Check the expiration of the profileMemory.Session token
+	key := profileKey(ep, data)
+	if cached, ok := profiles[key]; ok && cached.Session != "" {
+		// Check the expiration of the cached session token
 		p := jwt.Parser{}
 		claims := &jwt.StandardClaims{}
-		_, _, err := p.ParseUnverified(profileMemory.Session, claims)
+		_, _, err := p.ParseUnverified(cached.Session, claims)
 		if err != nil {
 			return nil, errors.Wrap(err, errSessionTokenParse)
 		}
@@ -111,11 +143,11 @@ func createOrUpdateProfile(ctx context.Context, data []byte, pcSpec *pcv1alpha1c
 		// before the token expires (claims.ExpiresAt - 10 minutes). This condition is
 		// used to determine if the token is close to expiration and requires refreshing.
 		if claims.ExpiresAt > 0 && time.Now().Unix() > claims.ExpiresAt-10*60 {
-			profileMemory.Session = ""
+			delete(profiles, key)
 			return nil, errors.New(errSessionTokenExpired)
 		}
 
-		return &profileMemory, nil
+		return &cached, nil
 	}
 
 	cliConfig := &CLIConfig{}
@@ -131,10 +163,6 @@ func createOrUpdateProfile(ctx context.Context, data []byte, pcSpec *pcv1alpha1c
 		return nil, errors.Wrap(err, errLoginFailed)
 	}
 
-	ep, err := getAPIEndpoint(pcSpec)
-	if err != nil {
-		return nil, errors.Wrap(err, errInvalidAPIEndpoint)
-	}
 	loginURL := createLoginURL(ep)
 	req, err := createLoginRequest(ctx, loginURL, jsonStr)
 	if err != nil {
@@ -160,11 +188,22 @@ func createOrUpdateProfile(ctx context.Context, data []byte, pcSpec *pcv1alpha1c
 		profile.Session = session
 	}
 	profile.Account = pcSpec.Organization
-	profileMemory = profile
+	profiles[key] = profile
 
 	return &profile, nil
 }
 
+// profileKey returns the key of the profile that logs in to the supplied API
+// endpoint with the supplied credentials. The credentials are hashed so that
+// they are not kept in the key.
+func profileKey(ep *url.URL, data []byte) string {
+	h := sha256.New()
+	h.Write([]byte(ep.String()))
+	h.Write([]byte{0})
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func createLoginURL(apiEndpoint *url.URL) *url.URL {
 	loginURL := &url.URL{
 		Scheme: apiEndpoint.Scheme,
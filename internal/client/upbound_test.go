@@ -0,0 +1,126 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/go-cmp/cmp"
+
+	pcv1alpha1common "github.com/upbound/provider-upbound/apis/common/providerconfig/v1alpha1"
+)
+
+// signed returns a token with the supplied ID and expiry.
+func signed(t *testing.T, id string, exp time.Time) string {
+	t.Helper()
+	s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{Id: id, ExpiresAt: exp.Unix()}).SignedString([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestCreateOrUpdateProfile(t *testing.T) {
+	// The login endpoint returns a session of the user that logged in.
+	var logins []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := &auth{}
+		if err := json.NewDecoder(r.Body).Decode(a); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		logins = append(logins, a.ID)
+		http.SetCookie(w, &http.Cookie{Name: CookieName, Value: signed(t, "session-"+a.ID, time.Now().Add(time.Hour))})
+	}))
+	defer srv.Close()
+
+	profiles = map[string]Profile{}
+	spec := &pcv1alpha1common.ProviderConfigSpec{Endpoint: &srv.URL}
+	session := func(token string) string {
+		t.Helper()
+		p, err := createOrUpdateProfile(context.Background(), []byte(token), spec)
+		if err != nil {
+			t.Fatalf("createOrUpdateProfile(...): %v", err)
+		}
+		claims := &jwt.StandardClaims{}
+		if _, _, err := (&jwt.Parser{}).ParseUnverified(p.Session, claims); err != nil {
+			t.Fatal(err)
+		}
+		return claims.Id
+	}
+
+	a, b := signed(t, "a", time.Now().Add(time.Hour)), signed(t, "b", time.Now().Add(time.Hour))
+	got := []string{session(a), session(b), session(a)}
+	if diff := cmp.Diff([]string{"session-a", "session-b", "session-a"}, got); diff != "" {
+		t.Errorf("createOrUpdateProfile(...): -want sessions, +got sessions:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"a", "b"}, logins); diff != "" {
+		t.Errorf("createOrUpdateProfile(...): -want logins, +got logins:\n%s", diff)
+	}
+}
+
+func TestTokenProfile(t *testing.T) {
+	// The first session expires within the refresh margin, later ones do not.
+	var logins []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a := &auth{}
+		if err := json.NewDecoder(r.Body).Decode(a); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		exp := time.Now().Add(time.Hour)
+		if len(logins) == 0 {
+			exp = time.Now().Add(time.Minute)
+		}
+		logins = append(logins, a.ID)
+		http.SetCookie(w, &http.Cookie{Name: CookieName, Value: signed(t, fmt.Sprintf("session-%d", len(logins)), exp)})
+	}))
+	defer srv.Close()
+
+	profiles = map[string]Profile{}
+	spec := &pcv1alpha1common.ProviderConfigSpec{Endpoint: &srv.URL, Organization: "acme"}
+	tok := signed(t, "a", time.Now().Add(time.Hour))
+
+	var got []string
+	for range 2 {
+		p, err := TokenProfile(context.Background(), spec, tok)
+		if err != nil {
+			t.Fatalf("TokenProfile(...): %v", err)
+		}
+		if p.ID != "a" || p.Type != TokenProfileType || p.Account != "acme" {
+			t.Errorf("TokenProfile(...): unexpected profile %+v", p)
+		}
+		claims := &jwt.StandardClaims{}
+		if _, _, err := (&jwt.Parser{}).ParseUnverified(p.Session, claims); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, claims.Id)
+	}
+	if diff := cmp.Diff([]string{"session-1", "session-2"}, got); diff != "" {
+		t.Errorf("TokenProfile(...): -want sessions, +got sessions:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"a", "a"}, logins); diff != "" {
+		t.Errorf("TokenProfile(...): -want logins, +got logins:\n%s", diff)
+	}
+}
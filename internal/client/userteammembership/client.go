@@ -0,0 +1,103 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userteammembership
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/upbound/up-sdk-go"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+)
+
+const (
+	basePath = "v1/teams"
+)
+
+func NewClient(cfg *up.Config) *Client {
+	return &Client{
+		Config: cfg,
+	}
+}
+
+type Client struct {
+	*up.Config
+}
+
+// List returns the users that are members of the team with the supplied ID.
+func (c *Client) List(ctx context.Context, teamId string) ([]Member, error) {
+	req, err := c.Client.NewRequest(ctx, http.MethodGet, basePath, fmt.Sprintf("%s/members", teamId), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := []Member{}
+	if err := c.Client.Do(req, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Get returns the membership of the user with the supplied username or email
+// in the team with the supplied ID. It returns a not found error if the user
+// is not a member of the team.
+func (c *Client) Get(ctx context.Context, teamId string, user User) (*Member, error) {
+	members, err := c.List(ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if user.Matches(members[i].User) {
+			return &members[i], nil
+		}
+	}
+	return nil, &uperrors.Error{Status: http.StatusNotFound}
+}
+
+func (c *Client) Create(ctx context.Context, teamId string, params *CreateParameters) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPost, basePath, fmt.Sprintf("%s/members", teamId), params)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+func (c *Client) Update(ctx context.Context, teamId string, userId uint, params *UpdateParameters) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodPut, basePath, fmt.Sprintf("%s/members/%d", teamId, userId), params)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+func (c *Client) Delete(ctx context.Context, teamId string, userId uint) error {
+	req, err := c.Client.NewRequest(ctx, http.MethodDelete, basePath, fmt.Sprintf("%s/members/%d", teamId, userId), nil)
+	if err != nil {
+		return err
+	}
+	return c.Client.Do(req, nil)
+}
+
+// Matches reports whether the supplied user is the one identified by u.
+// Emails are compared case-insensitively.
+func (u User) Matches(o User) bool {
+	if u.Username != "" {
+		return u.Username == o.Username
+	}
+	return u.Email != "" && strings.EqualFold(u.Email, o.Email)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userteammembership
+
+// Team roles.
+const (
+	RoleMember string = "member"
+	RoleOwner  string = "owner"
+)
+
+// User identifies a user by username or email.
+type User struct {
+	ID       uint   `json:"id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+// Member is a user that is a member of a team.
+type Member struct {
+	User User   `json:"user"`
+	Role string `json:"role"`
+}
+
+type CreateParameters struct {
+	Username string `json:"username,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+type UpdateParameters struct {
+	Role string `json:"role"`
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplane
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	up "github.com/upbound/up-sdk-go"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/controlplanes"
+
+	controlplanev1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/controlplane/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/controlplane"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+)
+
+const (
+	errNotControlPlane    = "managed resource is not a ControlPlane custom resource"
+	errTrackPCUsage       = "cannot track ProviderConfig usage"
+	errNewClient          = "cannot create new Service"
+	errInvalidConfigID    = "configurationId is not a valid UUID"
+	errGetTokenSecret     = "cannot get kubeconfig token secret"
+	errKubeconfig         = "cannot build kubeconfig"
+	errTokenSecretKeyMiss = "kubeconfig token secret does not contain key %q"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage *resource.LegacyProviderConfigUsageTracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*controlplanev1alpha1cluster.ControlPlane)
+	if !ok {
+		return nil, errors.New(errNotControlPlane)
+	}
+
+	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		kube:          c.kube,
+		cfg:           cfg,
+		controlplanes: controlplanes.NewClient(cfg),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube          client.Client
+	cfg           *up.Config
+	controlplanes *controlplanes.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*controlplanev1alpha1cluster.ControlPlane)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotControlPlane)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := c.controlplanes.Get(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get control plane")
+	}
+	cr.Status.AtProvider.ControlPlaneObservation = controlplane.StatusFromResponse(*resp)
+	cr.Status.SetConditions(condition(resp.Status))
+
+	cd, err := c.connectionDetails(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// Control planes cannot be updated once they are created.
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialize(&cr.Spec.ForProvider, resp.ControlPlane),
+		ConnectionDetails:       cd,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*controlplanev1alpha1cluster.ControlPlane)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotControlPlane)
+	}
+	params := &controlplanes.ControlPlaneCreateParameters{
+		Name:        cr.Spec.ForProvider.Name,
+		Description: ptr.Deref(cr.Spec.ForProvider.Description, ""),
+	}
+	if id := cr.Spec.ForProvider.ConfigurationID; id != nil {
+		u, err := uuid.Parse(*id)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errInvalidConfigID)
+		}
+		params.ConfigurationID = &u
+	}
+	if _, err := c.controlplanes.Create(ctx, cr.Spec.ForProvider.OrganizationName, params); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create control plane")
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Control planes cannot be updated once they are created.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*controlplanev1alpha1cluster.ControlPlane)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotControlPlane)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.controlplanes.Delete(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr))), "cannot delete control plane")
+}
+
+// connectionDetails returns a kubeconfig for the control plane, including the
+// token referenced by the control plane, if any.
+func (c *external) connectionDetails(ctx context.Context, cr *controlplanev1alpha1cluster.ControlPlane) (managed.ConnectionDetails, error) {
+	var token []byte
+	if ref := cr.Spec.ForProvider.KubeconfigTokenSecretRef; ref != nil {
+		s := &corev1.Secret{}
+		if err := c.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetTokenSecret)
+		}
+		t, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.Errorf(errTokenSecretKeyMiss, ref.Key)
+		}
+		token = t
+	}
+	kc, err := controlplane.Kubeconfig(c.cfg, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr), token)
+	if err != nil {
+		return nil, errors.Wrap(err, errKubeconfig)
+	}
+	return managed.ConnectionDetails{controlplane.ConnectionSecretKeyKubeconfig: kc}, nil
+}
+
+// condition returns the Ready condition mirroring the supplied control plane
+// status.
+func condition(s controlplanes.Status) xpv1.Condition {
+	switch s {
+	case controlplanes.StatusReady, controlplanes.StatusUpdating:
+		return xpv1.Available()
+	case controlplanes.StatusProvisioning:
+		return xpv1.Creating()
+	case controlplanes.StatusDeleting:
+		return xpv1.Deleting()
+	default:
+		return xpv1.Unavailable()
+	}
+}
+
+// lateInitialize sets the unset fields of the supplied parameters from the
+// supplied control plane. It returns true if any of them was set.
+func lateInitialize(p *controlplanev1alpha1cluster.ControlPlaneParameters, cp controlplanes.ControlPlane) bool {
+	li := upclient.LateInitializeString(&p.Name, cp.Name)
+	li = upclient.LateInitialize(&p.Description, cp.Description) || li
+	if cp.Configuration != nil && cp.Configuration.ID != uuid.Nil {
+		li = upclient.LateInitialize(&p.ConfigurationID, cp.Configuration.ID.String()) || li
+	}
+	return li
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplane
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	controlplanev1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/controlplane/v1alpha1"
+	apisv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/features"
+)
+
+// SetupGated calls setup when the legacy
+// ControlPlane GVR becomes available in the API.
+func SetupGated(mgr ctrl.Manager, o xpcontroller.Options) error {
+	o.Gate.Register(func() {
+		if err := setup(mgr, o); err != nil {
+			panic(err)
+		}
+	}, controlplanev1alpha1cluster.ControlPlaneGroupVersionKind)
+	return nil
+}
+
+// setup adds a controller that reconciles ControlPlane managed resources.
+func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(controlplanev1alpha1cluster.ControlPlaneGroupKind)
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1cluster.ProviderConfigUsage{}),
+		}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(controlplanev1alpha1cluster.ControlPlaneGroupVersionKind),
+		reconcilerOpts...)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&controlplanev1alpha1cluster.ControlPlane{}).
+		Complete(r)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controlplanepermission
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/controlplanepermission"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+)
+
+const (
+	errNotControlPlanePermission = "managed resource is not a ControlPlanePermission custom resource"
+	errTrackPCUsage              = "cannot track ProviderConfig usage"
+	errNewClient                 = "cannot create new client"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage *resource.LegacyProviderConfigUsageTracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.ControlPlanePermission)
+	if !ok {
+		return nil, errors.New(errNotControlPlanePermission)
+	}
+
+	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		permissionsCli: controlplanepermission.NewClient(cfg),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	permissionsCli *controlplanepermission.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.ControlPlanePermission)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotControlPlanePermission)
+	}
+
+	resp, err := c.permissionsCli.Get(ctx, getParameters(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "failed to get control plane permission")
+	}
+	cr.Status.AtProvider.Permission = resp.Permission
+	cr.Status.SetConditions(v1.Available())
+	li := upclient.LateInitializeString(&cr.Spec.ForProvider.Permission, resp.Permission)
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        resp.Permission == cr.Spec.ForProvider.Permission,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.ControlPlanePermission)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotControlPlanePermission)
+	}
+	if cr.Spec.ForProvider.Permission == "" {
+		return managed.ExternalCreation{}, errors.New("permission is required to grant a control plane permission")
+	}
+
+	if err := c.permissionsCli.Set(ctx, setParameters(cr)); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create control plane permission")
+	}
+
+	meta.SetExternalName(cr, ptr.Deref(cr.Spec.ForProvider.ControlPlane, ""))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.ControlPlanePermission)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotControlPlanePermission)
+	}
+
+	return managed.ExternalUpdate{}, errors.Wrap(c.permissionsCli.Set(ctx, setParameters(cr)), "failed to update control plane permission")
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.ControlPlanePermission)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotControlPlanePermission)
+	}
+
+	err := c.permissionsCli.Delete(ctx, getParameters(cr))
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot delete control plane permission")
+}
+
+func getParameters(cr *iamv1alpha1cluster.ControlPlanePermission) *controlplanepermission.GetParameters {
+	return &controlplanepermission.GetParameters{
+		ControlPlane: ptr.Deref(cr.Spec.ForProvider.ControlPlane, ""),
+		Organization: cr.Spec.ForProvider.OrganizationName,
+		TeamID:       ptr.Deref(cr.Spec.ForProvider.TeamID, ""),
+	}
+}
+
+func setParameters(cr *iamv1alpha1cluster.ControlPlanePermission) *controlplanepermission.SetParameters {
+	return &controlplanepermission.SetParameters{
+		ControlPlane: ptr.Deref(cr.Spec.ForProvider.ControlPlane, ""),
+		Organization: cr.Spec.ForProvider.OrganizationName,
+		TeamID:       ptr.Deref(cr.Spec.ForProvider.TeamID, ""),
+		Permission:   cr.Spec.ForProvider.Permission,
+	}
+}
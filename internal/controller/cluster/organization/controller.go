@@ -0,0 +1,191 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/accounts"
+	"github.com/upbound/up-sdk-go/service/organizations"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/organization"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+)
+
+const (
+	errNotOrganization = "managed resource is not an Organization custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errNewClient       = "cannot create new client"
+	errUpdateManaged   = "cannot update managed resource"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage *resource.LegacyProviderConfigUsageTracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.Organization)
+	if !ok {
+		return nil, errors.New(errNotOrganization)
+	}
+
+	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		accounts:      accounts.NewClient(cfg),
+		organizations: organizations.NewClient(cfg),
+		organization:  organization.NewClient(cfg),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	accounts      *accounts.Client
+	organizations *organizations.Client
+	organization  *organization.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.Organization)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganization)
+	}
+
+	acc, err := c.accounts.Get(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get account")
+	}
+	if acc.Account.Type != accounts.AccountOrganization || acc.Organization == nil {
+		return managed.ExternalObservation{}, errors.Errorf("account %s is not an organization", meta.GetExternalName(cr))
+	}
+
+	org, err := c.organization.Get(ctx, acc.Organization.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot get organization")
+	}
+	members, err := c.organizations.ListMembers(ctx, org.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot list organization members")
+	}
+	robots, err := c.organizations.ListRobots(ctx, org.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot list organization robots")
+	}
+	teams, err := c.organizations.ListTeams(ctx, org.ID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot list organization teams")
+	}
+
+	cr.Status.AtProvider = iamv1alpha1cluster.OrganizationObservation{
+		ID:          org.ID,
+		DisplayName: org.DisplayName,
+		AccountType: string(acc.Account.Type),
+		CreatorID:   org.CreatorID,
+		Role:        string(org.Role),
+		MemberCount: len(members),
+		RobotCount:  len(robots),
+		TeamCount:   len(teams),
+	}
+	if org.CreatedAt != nil {
+		cr.Status.AtProvider.CreatedAt = &metav1.Time{Time: *org.CreatedAt}
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// Organizations cannot be updated.
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: upclient.LateInitialize(&cr.Spec.ForProvider.DisplayName, org.DisplayName),
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.Organization)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganization)
+	}
+
+	err := c.organizations.Create(ctx, &organizations.OrganizationCreateParameters{
+		Name:        cr.Spec.ForProvider.Name,
+		DisplayName: ptr.Deref(cr.Spec.ForProvider.DisplayName, cr.Spec.ForProvider.Name),
+	})
+	return managed.ExternalCreation{}, errors.Wrap(err, "cannot create organization")
+}
+
+func (c *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Organizations cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.Organization)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotOrganization)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.organizations.Delete(ctx, cr.Status.AtProvider.ID)), "cannot delete organization")
+}
+
+// nameAsExternalName sets the external name of an Organization to the name of
+// the organization before it is observed, which is never the case for an
+// observe-only resource otherwise.
+func nameAsExternalName(kube client.Client) managed.InitializerFn {
+	return func(ctx context.Context, mg resource.Managed) error {
+		cr, ok := mg.(*iamv1alpha1cluster.Organization)
+		if !ok {
+			return errors.New(errNotOrganization)
+		}
+		if meta.GetExternalName(cr) != "" {
+			return nil
+		}
+		meta.SetExternalName(cr, cr.Spec.ForProvider.Name)
+		return errors.Wrap(kube.Update(ctx, cr), errUpdateManaged)
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationinvite
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/organizations"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/organizationinvite"
+	"github.com/upbound/provider-upbound/internal/client/userteammembership"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+)
+
+const (
+	errNotOrganizationInvite = "managed resource is not an OrganizationInvite custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errNewClient             = "cannot create new Service"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage *resource.LegacyProviderConfigUsageTracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.OrganizationInvite)
+	if !ok {
+		return nil, errors.New(errNotOrganizationInvite)
+	}
+
+	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		invites:       organizationinvite.NewClient(cfg),
+		organizations: organizations.NewClient(cfg),
+		memberships:   userteammembership.NewClient(cfg),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	invites       *organizationinvite.Client
+	organizations *organizations.Client
+	memberships   *userteammembership.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.OrganizationInvite)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganizationInvite)
+	}
+
+	// External name annotation is not used since an invite is identified by
+	// the organization and the email, which are both under spec.
+
+	orgID, err := c.organizations.GetOrgID(ctx, cr.Spec.ForProvider.OrganizationName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot get organization id")
+	}
+	inv, err := c.invites.Get(ctx, orgID, cr.Spec.ForProvider.Email)
+	if resource.Ignore(uperrors.IsNotFound, err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot get organization invite")
+	}
+	if err == nil {
+		cr.Status.AtProvider.ID = int(inv.ID)
+		cr.Status.AtProvider.State = iamv1alpha1cluster.InviteStatePending
+		cr.Status.SetConditions(v1.Available())
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: upclient.LateInitialize(&cr.Spec.ForProvider.Role, string(inv.Permission)),
+		}, nil
+	}
+
+	// An accepted invite disappears and its user becomes a member.
+	m, err := c.invites.GetMember(ctx, orgID, cr.Spec.ForProvider.Email)
+	if resource.Ignore(uperrors.IsNotFound, err) != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot get organization member")
+	}
+	if err != nil && cr.Status.AtProvider.State != iamv1alpha1cluster.InviteStateAccepted {
+		return managed.ExternalObservation{}, nil
+	}
+	// An invite that was accepted stays fulfilled even if the user has left
+	// the organization since.
+	joined := true
+	if err == nil {
+		cr.Status.AtProvider.UserID = int(m.User.ID)
+		if joined, err = c.joinedTeams(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	cr.Status.AtProvider.ID = 0
+	cr.Status.AtProvider.State = iamv1alpha1cluster.InviteStateAccepted
+	cr.Status.SetConditions(v1.Available())
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: joined}, nil
+}
+
+// joinedTeams reports whether the user of the supplied accepted invite is a
+// member of all teams of the invite.
+func (c *external) joinedTeams(ctx context.Context, cr *iamv1alpha1cluster.OrganizationInvite) (bool, error) {
+	user := userteammembership.User{Email: cr.Spec.ForProvider.Email}
+	for _, id := range cr.Spec.ForProvider.TeamIDs {
+		_, err := c.memberships.Get(ctx, id, user)
+		if uperrors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "cannot get membership in team %s", id)
+		}
+	}
+	return true, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.OrganizationInvite)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganizationInvite)
+	}
+	orgID, err := c.organizations.GetOrgID(ctx, cr.Spec.ForProvider.OrganizationName)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot get organization id")
+	}
+	err = c.invites.CreateInvite(ctx, orgID, &organizations.OrganizationInviteCreateParameters{
+		Email:      cr.Spec.ForProvider.Email,
+		Permission: organizations.OrganizationPermissionGroup(ptr.Deref(cr.Spec.ForProvider.Role, string(organizations.OrganizationMember))),
+	})
+	return managed.ExternalCreation{}, errors.Wrap(err, "cannot create organization invite")
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.OrganizationInvite)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganizationInvite)
+	}
+	// Invites cannot be updated, all parameters are immutable. The API does
+	// not let an invite carry teams, so the user is added to them once the
+	// invite was accepted.
+	if cr.Status.AtProvider.State != iamv1alpha1cluster.InviteStateAccepted {
+		return managed.ExternalUpdate{}, nil
+	}
+	user := userteammembership.User{Email: cr.Spec.ForProvider.Email}
+	for _, id := range cr.Spec.ForProvider.TeamIDs {
+		_, err := c.memberships.Get(ctx, id, user)
+		if err == nil {
+			continue
+		}
+		if !uperrors.IsNotFound(err) {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, "cannot get membership in team %s", id)
+		}
+		err = c.memberships.Create(ctx, id, &userteammembership.CreateParameters{Email: user.Email, Role: userteammembership.RoleMember})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, "cannot add user to team %s", id)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.OrganizationInvite)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotOrganizationInvite)
+	}
+	if cr.Status.AtProvider.State != iamv1alpha1cluster.InviteStatePending {
+		// Removing a member is not the business of an invite.
+		return managed.ExternalDelete{}, nil
+	}
+	orgID, err := c.organizations.GetOrgID(ctx, cr.Spec.ForProvider.OrganizationName)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, "cannot get organization id")
+	}
+	err = c.invites.DeleteInvite(ctx, orgID, uint(cr.Status.AtProvider.ID))
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot revoke organization invite")
+}
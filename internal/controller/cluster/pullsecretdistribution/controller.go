@@ -0,0 +1,310 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsecretdistribution
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"sort"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/token"
+)
+
+const (
+	errNotPullSecretDistribution = "managed resource is not a PullSecretDistribution custom resource"
+
+	// LabelKeyDistribution is the label the Secrets written by a
+	// PullSecretDistribution are found by. Its value is the UID of the
+	// PullSecretDistribution, since its name may be too long for a label
+	// value.
+	LabelKeyDistribution = "iam.upbound.io/pull-secret-distribution"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+	// secrets reads the distributed Secrets from the API server, so that
+	// they are not cached for the whole cluster.
+	secrets client.Reader
+}
+
+// Connect produces an ExternalClient that manages Secrets in the cluster.
+// A PullSecretDistribution does not call the Upbound API, so no
+// ProviderConfig is used.
+func (c *connector) Connect(_ context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.PullSecretDistribution)
+	if !ok {
+		return nil, errors.New(errNotPullSecretDistribution)
+	}
+	return &external{
+		kube:    c.kube,
+		reader:  c.secrets,
+		secrets: token.NewSecretPublisher(c.kube, token.WithSecretLabels(map[string]string{LabelKeyDistribution: string(cr.GetUID())})),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	kube    client.Client
+	reader  client.Reader
+	secrets *token.SecretPublisher
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.PullSecretDistribution)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPullSecretDistribution)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	selected, err := e.namespaces(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	current, err := e.distributed(ctx, cr, selected)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if meta.WasDeleted(cr) {
+		// The credentials do not matter anymore and the Token may already be
+		// gone, so only whether any Secrets are left is observed.
+		return managed.ExternalObservation{ResourceExists: len(current) > 0}, nil
+	}
+
+	creds, err := e.credentials(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	f := format(cr)
+	data, err := token.Render(ctx, f, creds, nil)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot format token credentials")
+	}
+
+	upToDate := true
+	namespaces := make([]string, 0, len(current))
+	for _, s := range current {
+		namespaces = append(namespaces, s.Namespace)
+		if !selected[s.Namespace] || s.Name != secretName(cr) || s.Type != token.SecretType(f) || !maps.EqualFunc(s.Data, data, bytes.Equal) {
+			upToDate = false
+		}
+	}
+	sort.Strings(namespaces)
+	if len(current) != len(selected) {
+		upToDate = false
+	}
+
+	cr.Status.AtProvider.TokenID = creds.ID
+	cr.Status.AtProvider.Namespaces = namespaces
+	cr.Status.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.PullSecretDistribution)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPullSecretDistribution)
+	}
+	meta.SetExternalName(cr, cr.GetName())
+	return managed.ExternalCreation{}, e.distribute(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.PullSecretDistribution)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPullSecretDistribution)
+	}
+	return managed.ExternalUpdate{}, e.distribute(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.PullSecretDistribution)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotPullSecretDistribution)
+	}
+	selected, err := e.namespaces(ctx, cr)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	current, err := e.distributed(ctx, cr, selected)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	for i := range current {
+		if err := e.kube.Delete(ctx, &current[i]); err != nil && !kerrors.IsNotFound(err) {
+			return managed.ExternalDelete{}, errors.Wrapf(err, "cannot delete secret %s", client.ObjectKeyFromObject(&current[i]))
+		}
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+// distribute writes the credentials of the referenced Token to every selected
+// namespace and removes the Secrets that are no longer wanted.
+func (e *external) distribute(ctx context.Context, cr *iamv1alpha1cluster.PullSecretDistribution) error {
+	creds, err := e.credentials(ctx, cr)
+	if err != nil {
+		return err
+	}
+	selected, err := e.namespaces(ctx, cr)
+	if err != nil {
+		return err
+	}
+	for ns := range selected {
+		ref := types.NamespacedName{Name: secretName(cr), Namespace: ns}
+		if err := e.secrets.Publish(ctx, cr, iamv1alpha1cluster.PullSecretDistributionGroupVersionKind, ref, format(cr), creds, nil); err != nil {
+			return errors.Wrap(err, "cannot publish token credentials")
+		}
+	}
+
+	current, err := e.distributed(ctx, cr, selected)
+	if err != nil {
+		return err
+	}
+	for i := range current {
+		s := &current[i]
+		if selected[s.Namespace] && s.Name == secretName(cr) {
+			continue
+		}
+		if err := e.kube.Delete(ctx, s); err != nil && !kerrors.IsNotFound(err) {
+			return errors.Wrapf(err, "cannot delete secret %s", client.ObjectKeyFromObject(s))
+		}
+	}
+	return nil
+}
+
+// credentials returns the credentials of the current token of the referenced
+// Token.
+func (e *external) credentials(ctx context.Context, cr *iamv1alpha1cluster.PullSecretDistribution) (token.Credentials, error) {
+	t := &iamv1alpha1cluster.Token{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: cr.Spec.ForProvider.TokenRef.Name}, t); err != nil {
+		return token.Credentials{}, errors.Wrapf(err, "cannot get token %s", cr.Spec.ForProvider.TokenRef.Name)
+	}
+	id := meta.GetExternalName(t)
+	if id == "" {
+		return token.Credentials{}, errors.Errorf("token %s has not been issued yet", t.GetName())
+	}
+	var refs []types.NamespacedName
+	if ref := t.GetWriteConnectionSecretToReference(); ref != nil {
+		refs = append(refs, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace})
+	}
+	if o := t.Spec.ForProvider.Output; o != nil && o.SecretRef != nil {
+		refs = append(refs, types.NamespacedName{Name: o.SecretRef.Name, Namespace: o.SecretRef.Namespace})
+	}
+	creds, ok, err := e.secrets.Lookup(ctx, id, refs...)
+	if err != nil {
+		return token.Credentials{}, errors.Wrap(err, "cannot look up token credentials")
+	}
+	if !ok {
+		return token.Credentials{}, errors.Errorf("cannot find credentials of token %s in its connection or output secret", t.GetName())
+	}
+	return creds, nil
+}
+
+// namespaces returns the names of the namespaces selected by the supplied
+// PullSecretDistribution. Terminating namespaces are skipped since no Secrets
+// can be created in them.
+func (e *external) namespaces(ctx context.Context, cr *iamv1alpha1cluster.PullSecretDistribution) (map[string]bool, error) {
+	sel, err := metav1.LabelSelectorAsSelector(&cr.Spec.ForProvider.NamespaceSelector)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse namespace selector")
+	}
+	l := &corev1.NamespaceList{}
+	if err := e.kube.List(ctx, l, client.MatchingLabelsSelector{Selector: sel}); err != nil {
+		return nil, errors.Wrap(err, "cannot list namespaces")
+	}
+	selected := make(map[string]bool, len(l.Items))
+	for _, ns := range l.Items {
+		if ns.Status.Phase == corev1.NamespaceTerminating {
+			continue
+		}
+		selected[ns.GetName()] = true
+	}
+	return selected, nil
+}
+
+// distributed returns the Secrets written by the supplied
+// PullSecretDistribution. Only the selected namespaces and those the Secrets
+// were last observed in are searched, rather than every namespace.
+func (e *external) distributed(ctx context.Context, cr *iamv1alpha1cluster.PullSecretDistribution, selected map[string]bool) ([]corev1.Secret, error) {
+	namespaces := make([]string, 0, len(selected)+len(cr.Status.AtProvider.Namespaces))
+	for ns := range selected {
+		namespaces = append(namespaces, ns)
+	}
+	for _, ns := range cr.Status.AtProvider.Namespaces {
+		if !selected[ns] {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+
+	var secrets []corev1.Secret
+	for _, ns := range namespaces {
+		l := &corev1.SecretList{}
+		if err := e.reader.List(ctx, l, client.InNamespace(ns), client.MatchingLabels{LabelKeyDistribution: string(cr.GetUID())}); err != nil {
+			return nil, errors.Wrapf(err, "cannot list distributed secrets in namespace %s", ns)
+		}
+		for _, s := range l.Items {
+			if o := metav1.GetControllerOf(&s); o != nil && o.UID == cr.GetUID() {
+				secrets = append(secrets, s)
+			}
+		}
+	}
+	return secrets, nil
+}
+
+// secretName returns the name of the Secrets written by the supplied
+// PullSecretDistribution.
+func secretName(cr *iamv1alpha1cluster.PullSecretDistribution) string {
+	return ptr.Deref(cr.Spec.ForProvider.SecretName, cr.GetName())
+}
+
+// format returns the format the supplied PullSecretDistribution writes the
+// credentials in.
+func format(cr *iamv1alpha1cluster.PullSecretDistribution) iamv1alpha1common.TokenFormat {
+	return iamv1alpha1common.TokenFormat{
+		Format:   cr.Spec.ForProvider.Format,
+		Registry: cr.Spec.ForProvider.Registry,
+	}
+}
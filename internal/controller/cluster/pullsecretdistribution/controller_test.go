@@ -0,0 +1,359 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsecretdistribution
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/token"
+)
+
+const (
+	distUID = types.UID("distribution-uid")
+
+	// tokenNamespace holds the connection secret of the distributed Token.
+	tokenNamespace = "crossplane-system"
+)
+
+var creds = token.Credentials{ID: "token-id", Token: "jwt"}
+
+type distOption func(*iamv1alpha1cluster.PullSecretDistribution)
+
+func withStatusNamespaces(ns ...string) distOption {
+	return func(cr *iamv1alpha1cluster.PullSecretDistribution) {
+		cr.Status.AtProvider.Namespaces = ns
+	}
+}
+
+func withDeletion() distOption {
+	return func(cr *iamv1alpha1cluster.PullSecretDistribution) {
+		cr.SetDeletionTimestamp(&metav1.Time{})
+	}
+}
+
+// distribution returns a PullSecretDistribution that has been created and
+// selects the namespaces labelled pull=true.
+func distribution(o ...distOption) *iamv1alpha1cluster.PullSecretDistribution {
+	cr := &iamv1alpha1cluster.PullSecretDistribution{
+		ObjectMeta: metav1.ObjectMeta{
+			// The name is longer than a label value may be.
+			Name: "a-pull-secret-distribution-with-a-name-longer-than-sixty-three-characters",
+			UID:  distUID,
+		},
+		Spec: iamv1alpha1cluster.PullSecretDistributionSpec{
+			ForProvider: iamv1alpha1cluster.PullSecretDistributionParameters{
+				TokenRef:          xpv1.Reference{Name: "token"},
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pull": "true"}},
+				Format:            iamv1alpha1common.TokenOutputFormatDockerConfigJSON,
+			},
+		},
+	}
+	meta.SetExternalName(cr, cr.GetName())
+	for _, fn := range o {
+		fn(cr)
+	}
+	return cr
+}
+
+// cluster returns the objects every case starts with: the distributed Token
+// and its connection secret, the selected namespaces a and b, the unselected
+// namespace c and the selected but terminating namespace d.
+func cluster() []client.Object {
+	t := &iamv1alpha1cluster.Token{ObjectMeta: metav1.ObjectMeta{Name: "token"}}
+	meta.SetExternalName(t, creds.ID)
+	t.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "token", Namespace: tokenNamespace})
+
+	ns := func(name string, selected bool, phase corev1.NamespacePhase) *corev1.Namespace {
+		n := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}, Status: corev1.NamespaceStatus{Phase: phase}}
+		if selected {
+			n.SetLabels(map[string]string{"pull": "true"})
+		}
+		return n
+	}
+	return []client.Object{
+		t,
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: tokenNamespace},
+			Data:       map[string][]byte{token.KeyID: []byte(creds.ID), token.KeyToken: []byte(creds.Token)},
+		},
+		ns(tokenNamespace, false, corev1.NamespaceActive),
+		ns("a", true, corev1.NamespaceActive),
+		ns("b", true, corev1.NamespaceActive),
+		ns("c", false, corev1.NamespaceActive),
+		ns("d", true, corev1.NamespaceTerminating),
+	}
+}
+
+// distributed returns a Secret written by the distribution to the supplied
+// namespace in the supplied format.
+func distributed(t *testing.T, ns string, f iamv1alpha1common.TokenOutputFormat) *corev1.Secret {
+	t.Helper()
+	tf := iamv1alpha1common.TokenFormat{Format: f}
+	data, err := token.Render(context.Background(), tf, creds, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            distribution().GetName(),
+			Namespace:       ns,
+			Labels:          map[string]string{LabelKeyDistribution: string(distUID)},
+			OwnerReferences: []metav1.OwnerReference{meta.AsController(meta.TypedReferenceTo(distribution(), iamv1alpha1cluster.PullSecretDistributionGroupVersionKind))},
+		},
+		Type: token.SecretType(tf),
+		Data: data,
+	}
+}
+
+func newExternal(t *testing.T, objs ...client.Object) (*external, client.Client) {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := iamv1alpha1cluster.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(append(cluster(), objs...)...).Build()
+	e, err := (&connector{kube: kube, secrets: kube}).Connect(context.Background(), distribution())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e.(*external), kube
+}
+
+// secrets returns the types of the Secrets in the cluster by namespace and
+// name, except for the connection secret of the Token.
+func secrets(t *testing.T, kube client.Client) map[string]corev1.SecretType {
+	t.Helper()
+	l := &corev1.SecretList{}
+	if err := kube.List(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]corev1.SecretType{}
+	for _, s := range l.Items {
+		if s.Namespace != tokenNamespace {
+			got[s.Namespace+"/"+s.Name] = s.Type
+		}
+	}
+	return got
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o          managed.ExternalObservation
+		namespaces []string
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		cr      *iamv1alpha1cluster.PullSecretDistribution
+		secrets []client.Object
+		want    want
+	}{
+		"NotCreated": {
+			reason: "A distribution without an external name does not exist yet.",
+			cr: func() *iamv1alpha1cluster.PullSecretDistribution {
+				cr := distribution()
+				meta.SetExternalName(cr, "")
+				return cr
+			}(),
+			want: want{o: managed.ExternalObservation{}},
+		},
+		"UpToDate": {
+			reason: "Secrets in every selected namespace but the terminating one are up to date.",
+			cr:     distribution(),
+			secrets: []client.Object{
+				distributed(t, "a", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+				distributed(t, "b", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+			},
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				namespaces: []string{"a", "b"},
+			},
+		},
+		"MissingSecret": {
+			reason: "A selected namespace without the Secret is not up to date.",
+			cr:     distribution(),
+			secrets: []client.Object{
+				distributed(t, "a", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+			},
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true},
+				namespaces: []string{"a"},
+			},
+		},
+		"StaleSecret": {
+			reason: "A Secret in a namespace that is no longer selected is found through the status and is not up to date.",
+			cr:     distribution(withStatusNamespaces("a", "b", "c")),
+			secrets: []client.Object{
+				distributed(t, "a", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+				distributed(t, "b", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+				distributed(t, "c", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+			},
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true},
+				namespaces: []string{"a", "b", "c"},
+			},
+		},
+		"TypeChanged": {
+			reason: "A Secret of another type than the format requires is not up to date.",
+			cr:     distribution(),
+			secrets: []client.Object{
+				distributed(t, "a", iamv1alpha1common.TokenOutputFormatRaw),
+				distributed(t, "b", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+			},
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true},
+				namespaces: []string{"a", "b"},
+			},
+		},
+		"Deleting": {
+			reason: "A deleted distribution exists as long as any of its Secrets do.",
+			cr:     distribution(withDeletion(), withStatusNamespaces("c")),
+			secrets: []client.Object{
+				distributed(t, "c", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+			},
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true},
+				namespaces: []string{"c"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, _ := newExternal(t, tc.secrets...)
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\ne.Observe(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if tc.want.o.ResourceExists && !meta.WasDeleted(tc.cr) {
+				if diff := cmp.Diff(tc.want.namespaces, tc.cr.Status.AtProvider.Namespaces); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want namespaces, +got namespaces:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	dockerConfig := corev1.SecretTypeDockerConfigJson
+	name := distribution().GetName()
+
+	cases := map[string]struct {
+		reason  string
+		cr      *iamv1alpha1cluster.PullSecretDistribution
+		secrets []client.Object
+		want    map[string]corev1.SecretType
+	}{
+		"Distribute": {
+			reason: "The credentials are written to every selected namespace but the terminating one.",
+			cr:     distribution(),
+			want: map[string]corev1.SecretType{
+				"a/" + name: dockerConfig,
+				"b/" + name: dockerConfig,
+			},
+		},
+		"PruneStale": {
+			reason: "The Secret in a namespace that is no longer selected is deleted.",
+			cr:     distribution(withStatusNamespaces("a", "b", "c")),
+			secrets: []client.Object{
+				distributed(t, "a", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+				distributed(t, "b", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+				distributed(t, "c", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+			},
+			want: map[string]corev1.SecretType{
+				"a/" + name: dockerConfig,
+				"b/" + name: dockerConfig,
+			},
+		},
+		"RecreateOnTypeChange": {
+			reason: "A Secret of another type is recreated since the type of a Secret is immutable.",
+			cr:     distribution(withStatusNamespaces("a", "b")),
+			secrets: []client.Object{
+				distributed(t, "a", iamv1alpha1common.TokenOutputFormatRaw),
+				distributed(t, "b", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+			},
+			want: map[string]corev1.SecretType{
+				"a/" + name: dockerConfig,
+				"b/" + name: dockerConfig,
+			},
+		},
+		"SkipTerminating": {
+			reason: "No Secret is written to a terminating namespace, and the one it still holds is removed.",
+			cr:     distribution(withStatusNamespaces("a", "b", "d")),
+			secrets: []client.Object{
+				distributed(t, "d", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+			},
+			want: map[string]corev1.SecretType{
+				"a/" + name: dockerConfig,
+				"b/" + name: dockerConfig,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, kube := newExternal(t, tc.secrets...)
+			if _, err := e.Update(context.Background(), tc.cr); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, secrets(t, kube)); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want secrets, +got secrets:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	// A Secret with the label that another owner controls is left alone.
+	foreign := distributed(t, "b", iamv1alpha1common.TokenOutputFormatDockerConfigJSON)
+	foreign.OwnerReferences[0].UID = "other-uid"
+
+	e, kube := newExternal(t,
+		distributed(t, "a", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+		distributed(t, "c", iamv1alpha1common.TokenOutputFormatDockerConfigJSON),
+		foreign,
+	)
+	if _, err := e.Delete(context.Background(), distribution(withDeletion(), withStatusNamespaces("a", "c"))); err != nil {
+		t.Fatalf("e.Delete(...): unexpected error: %v", err)
+	}
+	want := map[string]corev1.SecretType{"b/" + foreign.GetName(): corev1.SecretTypeDockerConfigJson}
+	if diff := cmp.Diff(want, secrets(t, kube)); diff != "" {
+		t.Errorf("e.Delete(...): -want secrets, +got secrets:\n%s", diff)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pullsecretdistribution
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpcontroller "github.com/crossplane/crossplane-runtime/v2/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/event"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/features"
+)
+
+// SetupGated calls setup when the legacy
+// PullSecretDistribution GVR becomes available in the API.
+func SetupGated(mgr ctrl.Manager, o xpcontroller.Options) error {
+	o.Gate.Register(func() {
+		if err := setup(mgr, o); err != nil {
+			panic(err)
+		}
+	}, iamv1alpha1cluster.PullSecretDistributionGroupVersionKind, iamv1alpha1cluster.TokenGroupVersionKind)
+	return nil
+}
+
+// setup adds a controller that reconciles PullSecretDistribution managed
+// resources.
+func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
+	name := managed.ControllerName(iamv1alpha1cluster.PullSecretDistributionGroupKind)
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithExternalConnector(&connector{kube: mgr.GetClient(), secrets: mgr.GetAPIReader()}),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(iamv1alpha1cluster.PullSecretDistributionGroupVersionKind),
+		reconcilerOpts...)
+
+	kube := mgr.GetClient()
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&iamv1alpha1cluster.PullSecretDistribution{}).
+		// A rotation changes the external name of the Token, and a new or
+		// relabelled namespace may need the credentials right away.
+		Watches(&iamv1alpha1cluster.Token{}, handler.EnqueueRequestsFromMapFunc(distributionsOf(kube, func(d iamv1alpha1cluster.PullSecretDistribution, o client.Object) bool {
+			return d.Spec.ForProvider.TokenRef.Name == o.GetName()
+		}))).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(distributionsOf(kube, func(_ iamv1alpha1cluster.PullSecretDistribution, _ client.Object) bool {
+			return true
+		}))).
+		Complete(r)
+}
+
+// distributionsOf returns a function that enqueues the PullSecretDistributions
+// the supplied filter matches for a changed object.
+func distributionsOf(kube client.Client, matches func(iamv1alpha1cluster.PullSecretDistribution, client.Object) bool) handler.MapFunc {
+	return func(ctx context.Context, o client.Object) []reconcile.Request {
+		l := &iamv1alpha1cluster.PullSecretDistributionList{}
+		if err := kube.List(ctx, l); err != nil {
+			return nil
+		}
+		var reqs []reconcile.Request
+		for _, d := range l.Items {
+			if matches(d, o) {
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: d.GetName()}})
+			}
+		}
+		return reqs
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package repository
 
 import (
+	"cmp"
 	"context"
 
 	"github.com/pkg/errors"
@@ -66,13 +67,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
-	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	cfg, profile, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
 	return &external{
 		repositories: &sdkClient{upstream: repositories.NewClient(cfg)},
+		organization: profile.Account,
 	}, nil
 }
 
@@ -85,6 +87,10 @@ func (e *external) Disconnect(_ context.Context) error {
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
 	repositories RepoClient
+
+	// organization is the organization of the ProviderConfig, which
+	// repositories belong to unless they specify one.
+	organization string
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -97,7 +103,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, nil
 	}
 
-	resp, err := c.repositories.Get(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr))
+	resp, err := c.repositories.Get(ctx, c.organizationName(cr), meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get repository")
 	}
@@ -108,17 +114,21 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(v1.Available())
 	cr.Status.AtProvider.RepositoryObservation = repository.StatusFromResponse(repoList.Repositories[0])
+	cr.Status.AtProvider.OrganizationName = c.organizationName(cr)
+
+	li := lateInitialize(&cr.Spec.ForProvider, resp.Repository)
 
 	publishPolicy := repositories.PublishPolicy("draft")
-	if cr.Spec.ForProvider.Publish {
+	if ptr.Deref(cr.Spec.ForProvider.Publish, false) {
 		publishPolicy = repositories.PublishPolicy("publish")
 	}
 
-	resourceUpToDate := cr.Spec.ForProvider.Public == resp.Public || ptr.Deref(resp.Publish, repositories.PublishPolicy("")) == publishPolicy
+	resourceUpToDate := ptr.Deref(cr.Spec.ForProvider.Public, false) == resp.Public || ptr.Deref(resp.Publish, repositories.PublishPolicy("")) == publishPolicy
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: resourceUpToDate,
+		ResourceExists:          true,
+		ResourceUpToDate:        resourceUpToDate,
+		ResourceLateInitialized: li,
 	}, nil
 }
 
@@ -127,9 +137,9 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotRepository)
 	}
-	visibility := createOrUpdatePublic(cr.Spec.ForProvider.Public)
-	publishPolicy := createOrUpdatePublish(cr.Spec.ForProvider.Publish)
-	err := c.repositories.CreateOrUpdateWithOptions(ctx, cr.Spec.ForProvider.OrganizationName, cr.Spec.ForProvider.Name, visibility, publishPolicy)
+	visibility := createOrUpdatePublic(ptr.Deref(cr.Spec.ForProvider.Public, false))
+	publishPolicy := createOrUpdatePublish(ptr.Deref(cr.Spec.ForProvider.Publish, false))
+	err := c.repositories.CreateOrUpdateWithOptions(ctx, c.organizationName(cr), cr.Spec.ForProvider.Name, visibility, publishPolicy)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create repository")
 	}
@@ -142,10 +152,10 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotRepository)
 	}
-	visibility := createOrUpdatePublic(cr.Spec.ForProvider.Public)
-	publishPolicy := createOrUpdatePublish(cr.Spec.ForProvider.Publish)
+	visibility := createOrUpdatePublic(ptr.Deref(cr.Spec.ForProvider.Public, false))
+	publishPolicy := createOrUpdatePublish(ptr.Deref(cr.Spec.ForProvider.Publish, false))
 
-	err := c.repositories.CreateOrUpdateWithOptions(ctx, cr.Spec.ForProvider.OrganizationName, cr.Spec.ForProvider.Name, visibility, publishPolicy)
+	err := c.repositories.CreateOrUpdateWithOptions(ctx, c.organizationName(cr), cr.Spec.ForProvider.Name, visibility, publishPolicy)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update repository")
 	}
@@ -159,7 +169,13 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalDelete{}, errors.New(errNotRepository)
 	}
 
-	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.repositories.Delete(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr))), "cannot delete repository")
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.repositories.Delete(ctx, c.organizationName(cr), meta.GetExternalName(cr))), "cannot delete repository")
+}
+
+// organizationName returns the name of the organization of the supplied
+// Repository, which defaults to the organization of the ProviderConfig.
+func (c *external) organizationName(cr *repov1alpha1cluster.Repository) string {
+	return cmp.Or(cr.Spec.ForProvider.OrganizationName, c.organization)
 }
 
 func createOrUpdatePublic(isPublic bool) repositories.CreateOrUpdateOption {
@@ -177,3 +193,19 @@ func createOrUpdatePublish(publish bool) repositories.CreateOrUpdateOption {
 	}
 	return publishPolicy
 }
+
+// lateInitialize sets the visibility and publish policy of the supplied
+// parameters from the supplied repository if they are unset. It returns true
+// if any of them was set.
+func lateInitialize(p *repov1alpha1cluster.RepositoryParameters, r repositories.Repository) bool {
+	li := false
+	if p.Public == nil {
+		p.Public = ptr.To(r.Public)
+		li = true
+	}
+	if p.Publish == nil && r.Publish != nil {
+		p.Publish = ptr.To(*r.Publish == repositories.PublishPolicy("publish"))
+		li = true
+	}
+	return li
+}
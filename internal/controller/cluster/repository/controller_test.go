@@ -94,8 +94,8 @@ func TestObserve(t *testing.T) {
 					Spec: v1alpha1.RepositorySpec{
 						ForProvider: v1alpha1.RepositoryParameters{
 							OrganizationName: "org",
-							Public:           true,
-							Publish:          true,
+							Public:           ptr.To(true),
+							Publish:          ptr.To(true),
 						},
 					},
 					ObjectMeta: v1.ObjectMeta{
@@ -127,8 +127,8 @@ func TestObserve(t *testing.T) {
 					Spec: v1alpha1.RepositorySpec{
 						ForProvider: v1alpha1.RepositoryParameters{
 							OrganizationName: "org",
-							Public:           true,
-							Publish:          true,
+							Public:           ptr.To(true),
+							Publish:          ptr.To(true),
 						},
 					},
 					ObjectMeta: v1.ObjectMeta{
@@ -144,6 +144,38 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"RepoImported": {
+			setupMocks: func(m *mockClient) {
+				m.getFn = func(ctx context.Context, org, repo string) (*repositories.RepositoryResponse, error) {
+					return &repositories.RepositoryResponse{
+						Repository: repositories.Repository{
+							Public:  true,
+							Publish: ptr.To(repositories.PublishPolicy("publish")),
+						},
+					}, nil
+				}
+			},
+			args: args{
+				mg: &v1alpha1.Repository{
+					Spec: v1alpha1.RepositorySpec{
+						ForProvider: v1alpha1.RepositoryParameters{
+							OrganizationName: "org",
+						},
+					},
+					ObjectMeta: v1.ObjectMeta{
+						Annotations: map[string]string{"crossplane.io/external-name": "name"},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				err: nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
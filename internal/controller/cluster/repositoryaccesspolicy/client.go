@@ -0,0 +1,43 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryaccesspolicy
+
+import (
+	"context"
+
+	"github.com/upbound/up-sdk-go/service/accounts"
+
+	"github.com/upbound/provider-upbound/internal/client/repositorypermission"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+)
+
+// PermissionClient manages the permissions of teams on repositories.
+type PermissionClient interface {
+	Create(ctx context.Context, params *repositorypermission.CreateParameters) error
+	List(ctx context.Context, params *repositorypermission.ListParameters) ([]repositorypermission.Grant, error)
+	Delete(ctx context.Context, params *repositorypermission.GetParameters) error
+}
+
+// TeamClient lists the teams of organizations.
+type TeamClient interface {
+	List(ctx context.Context, orgID uint) ([]teams.Team, error)
+}
+
+// AccountClient looks up accounts.
+type AccountClient interface {
+	Get(ctx context.Context, name string) (*accounts.AccountResponse, error)
+}
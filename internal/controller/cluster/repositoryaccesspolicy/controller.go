@@ -0,0 +1,326 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryaccesspolicy
+
+import (
+	"context"
+	"sort"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/accounts"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	repov1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/repository/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/repositorypermission"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+)
+
+const (
+	errNotRepositoryAccessPolicy = "managed resource is not a RepositoryAccessPolicy custom resource"
+	errTrackPCUsage              = "cannot track ProviderConfig usage"
+	errNewClient                 = "cannot create new client"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage *resource.LegacyProviderConfigUsageTracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*repov1alpha1cluster.RepositoryAccessPolicy)
+	if !ok {
+		return nil, errors.New(errNotRepositoryAccessPolicy)
+	}
+
+	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		permissionsCli: repositorypermission.NewClient(cfg),
+		teams:          teams.NewClient(cfg),
+		accounts:       accounts.NewClient(cfg),
+		annotations:    managed.NewRetryingCriticalAnnotationUpdater(c.kube),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	permissionsCli PermissionClient
+	teams          TeamClient
+	accounts       AccountClient
+
+	// annotations persists the external name of a policy that adopted the
+	// existing grants of its repository.
+	annotations managed.CriticalAnnotationUpdater
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*repov1alpha1cluster.RepositoryAccessPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepositoryAccessPolicy)
+	}
+
+	// A policy has no external resource of its own. It exists once its grants
+	// have been applied for the first time, or once it adopted the grants of
+	// its repository.
+	if meta.GetExternalName(cr) == "" {
+		return c.adopt(ctx, cr)
+	}
+
+	observed, err := c.grants(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	return observation(cr, observed, false), nil
+}
+
+// adopt imports the grants the repository of the supplied policy currently
+// has as its grants. Grants are only adopted before the policy is created,
+// when it lists no grants and is annotated to adopt existing grants. A policy
+// that was created is never adopted, so it does not take over and later
+// revoke grants it did not list.
+func (c *external) adopt(ctx context.Context, cr *repov1alpha1cluster.RepositoryAccessPolicy) (managed.ExternalObservation, error) {
+	if meta.WasDeleted(cr) || cr.Spec.ForProvider.Grants != nil || cr.GetAnnotations()[upclient.AnnotationKeyAdoptExisting] != "true" {
+		return managed.ExternalObservation{}, nil
+	}
+	observed, err := c.grants(ctx, cr)
+	if err != nil || len(observed) == 0 {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Spec.ForProvider.Grants = grantsFrom(observed)
+	meta.SetExternalName(cr, ptr.Deref(cr.Spec.ForProvider.Repository, ""))
+	if err := c.annotations.UpdateCriticalAnnotations(ctx, cr); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot record adopted grants")
+	}
+	return observation(cr, observed, true), nil
+}
+
+// observation returns the observation of the supplied policy given the
+// supplied observed permissions by team ID, and reports them in its status.
+func observation(cr *repov1alpha1cluster.RepositoryAccessPolicy, observed map[string]string, li bool) managed.ExternalObservation {
+	listed, unmanaged, upToDate := compare(cr.Spec.ForProvider.Grants, observed)
+	cr.Status.AtProvider.Grants = listed
+	cr.Status.AtProvider.UnmanagedGrants = unmanaged
+	cr.Status.SetConditions(v1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate && (!cr.Spec.ForProvider.Exclusive || len(unmanaged) == 0),
+		ResourceLateInitialized: li,
+	}
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*repov1alpha1cluster.RepositoryAccessPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRepositoryAccessPolicy)
+	}
+
+	if err := c.apply(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, ptr.Deref(cr.Spec.ForProvider.Repository, ""))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*repov1alpha1cluster.RepositoryAccessPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRepositoryAccessPolicy)
+	}
+
+	if err := c.apply(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !cr.Spec.ForProvider.Exclusive {
+		return managed.ExternalUpdate{}, nil
+	}
+	// Unmanaged grants were reported in the status by Observe.
+	for _, g := range cr.Status.AtProvider.UnmanagedGrants {
+		err := c.permissionsCli.Delete(ctx, &repositorypermission.GetParameters{
+			Repository:   ptr.Deref(cr.Spec.ForProvider.Repository, ""),
+			Organization: cr.Spec.ForProvider.OrganizationName,
+			TeamID:       g.TeamID,
+		})
+		if resource.Ignore(uperrors.IsNotFound, err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, "cannot revoke repository permission of team %s", g.TeamID)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*repov1alpha1cluster.RepositoryAccessPolicy)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotRepositoryAccessPolicy)
+	}
+
+	// Only the grants listed in the policy are revoked.
+	for _, g := range cr.Spec.ForProvider.Grants {
+		err := c.permissionsCli.Delete(ctx, &repositorypermission.GetParameters{
+			Repository:   ptr.Deref(cr.Spec.ForProvider.Repository, ""),
+			Organization: cr.Spec.ForProvider.OrganizationName,
+			TeamID:       ptr.Deref(g.TeamID, ""),
+		})
+		if resource.Ignore(uperrors.IsNotFound, err) != nil {
+			return managed.ExternalDelete{}, errors.Wrapf(err, "cannot revoke repository permission of team %s", ptr.Deref(g.TeamID, ""))
+		}
+	}
+	return managed.ExternalDelete{}, nil
+}
+
+// apply grants the permissions listed in the supplied policy.
+func (c *external) apply(ctx context.Context, cr *repov1alpha1cluster.RepositoryAccessPolicy) error {
+	for _, g := range cr.Spec.ForProvider.Grants {
+		err := c.permissionsCli.Create(ctx, &repositorypermission.CreateParameters{
+			Repository:   ptr.Deref(cr.Spec.ForProvider.Repository, ""),
+			Organization: cr.Spec.ForProvider.OrganizationName,
+			TeamID:       ptr.Deref(g.TeamID, ""),
+			Permission:   g.Permission,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "cannot grant repository permission to team %s", ptr.Deref(g.TeamID, ""))
+		}
+	}
+	return nil
+}
+
+// grants returns the permissions of teams on the repository of the supplied
+// policy, keyed by team ID. Permissions can only be listed by team, so only
+// the teams listed in the policy are looked at unless the permissions of all
+// teams of the organization are needed to prune or adopt them.
+func (c *external) grants(ctx context.Context, cr *repov1alpha1cluster.RepositoryAccessPolicy) (map[string]string, error) {
+	var ids []string
+	if cr.Spec.ForProvider.Exclusive || meta.GetExternalName(cr) == "" {
+		all, err := c.teamIDs(ctx, cr.Spec.ForProvider.OrganizationName)
+		if err != nil {
+			return nil, err
+		}
+		ids = all
+	} else {
+		for _, g := range cr.Spec.ForProvider.Grants {
+			ids = append(ids, ptr.Deref(g.TeamID, ""))
+		}
+	}
+
+	repo := ptr.Deref(cr.Spec.ForProvider.Repository, "")
+	observed := map[string]string{}
+	for _, id := range ids {
+		ps, err := c.permissionsCli.List(ctx, &repositorypermission.ListParameters{
+			Organization: cr.Spec.ForProvider.OrganizationName,
+			TeamID:       id,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot list repository permissions of team %s", id)
+		}
+		for _, p := range ps {
+			if p.RepositoryName == repo {
+				observed[id] = p.Privilege
+			}
+		}
+	}
+	return observed, nil
+}
+
+// teamIDs returns the IDs of all teams of the supplied organization.
+func (c *external) teamIDs(ctx context.Context, org string) ([]string, error) {
+	acc, err := c.accounts.Get(ctx, org)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get account %s", org)
+	}
+	if acc.Account.Type != accounts.AccountOrganization || acc.Organization == nil {
+		return nil, errors.Errorf("given account %s is not an organization", org)
+	}
+	ts, err := c.teams.List(ctx, acc.Organization.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list teams")
+	}
+	ids := make([]string, 0, len(ts))
+	for _, t := range ts {
+		ids = append(ids, t.ID)
+	}
+	return ids, nil
+}
+
+// grantsFrom returns the supplied observed permissions by team ID as grants,
+// sorted by team ID.
+func grantsFrom(observed map[string]string) []repov1alpha1cluster.RepositoryGrant {
+	ids := make([]string, 0, len(observed))
+	for id := range observed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	grants := make([]repov1alpha1cluster.RepositoryGrant, 0, len(ids))
+	for _, id := range ids {
+		grants = append(grants, repov1alpha1cluster.RepositoryGrant{TeamID: ptr.To(id), Permission: observed[id]})
+	}
+	return grants
+}
+
+// compare splits the observed grants, keyed by team ID, into those of teams
+// that are listed in the desired grants and those that are not. It reports
+// whether every desired grant is observed with the desired permission.
+func compare(desired []repov1alpha1cluster.RepositoryGrant, observed map[string]string) (listed, unmanaged []repov1alpha1cluster.ObservedRepositoryGrant, upToDate bool) {
+	upToDate = true
+	want := make(map[string]bool, len(desired))
+	for _, g := range desired {
+		id := ptr.Deref(g.TeamID, "")
+		want[id] = true
+		p, ok := observed[id]
+		if !ok || p != g.Permission {
+			upToDate = false
+		}
+		if ok {
+			listed = append(listed, repov1alpha1cluster.ObservedRepositoryGrant{TeamID: id, Permission: p})
+		}
+	}
+	for id, p := range observed {
+		if !want[id] {
+			unmanaged = append(unmanaged, repov1alpha1cluster.ObservedRepositoryGrant{TeamID: id, Permission: p})
+		}
+	}
+	sort.Slice(unmanaged, func(i, j int) bool { return unmanaged[i].TeamID < unmanaged[j].TeamID })
+	return listed, unmanaged, upToDate
+}
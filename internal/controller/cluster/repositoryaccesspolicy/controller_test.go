@@ -0,0 +1,366 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryaccesspolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/accounts"
+	"github.com/upbound/up-sdk-go/service/organizations"
+
+	repov1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/repository/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/repositorypermission"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+)
+
+// permissions are the permissions of the teams of the organization acme on
+// the repository repo and another repository.
+var permissions = map[string][]repositorypermission.Grant{
+	"t1": {{RepositoryName: "repo", Privilege: "read"}, {RepositoryName: "other", Privilege: "admin"}},
+	"t2": {{RepositoryName: "repo", Privilege: "write"}},
+	"t3": {{RepositoryName: "other", Privilege: "view"}},
+}
+
+// fake returns an external client backed by the permissions above that
+// records the calls it makes.
+func fake(calls *[]string) *external {
+	return &external{
+		accounts: &mockAccountClient{
+			getFn: func(_ context.Context, name string) (*accounts.AccountResponse, error) {
+				*calls = append(*calls, "get account "+name)
+				return &accounts.AccountResponse{
+					Account:      accounts.Account{Type: accounts.AccountOrganization},
+					Organization: &organizations.Organization{ID: 1},
+				}, nil
+			},
+		},
+		teams: &mockTeamClient{
+			listFn: func(_ context.Context, _ uint) ([]teams.Team, error) {
+				*calls = append(*calls, "list teams")
+				return []teams.Team{{ID: "t1"}, {ID: "t2"}, {ID: "t3"}}, nil
+			},
+		},
+		permissionsCli: &mockPermissionClient{
+			listFn: func(_ context.Context, params *repositorypermission.ListParameters) ([]repositorypermission.Grant, error) {
+				*calls = append(*calls, "list "+params.TeamID)
+				return permissions[params.TeamID], nil
+			},
+			createFn: func(_ context.Context, params *repositorypermission.CreateParameters) error {
+				*calls = append(*calls, fmt.Sprintf("grant %s %s", params.TeamID, params.Permission))
+				return nil
+			},
+			deleteFn: func(_ context.Context, params *repositorypermission.GetParameters) error {
+				*calls = append(*calls, "revoke "+params.TeamID)
+				if params.TeamID == "gone" {
+					return &uperrors.Error{Status: http.StatusNotFound}
+				}
+				return nil
+			},
+		},
+		annotations: managed.CriticalAnnotationUpdateFn(func(_ context.Context, o client.Object) error {
+			*calls = append(*calls, "record "+meta.GetExternalName(o))
+			return nil
+		}),
+	}
+}
+
+type policyOption func(*repov1alpha1cluster.RepositoryAccessPolicy)
+
+func withExternalName(n string) policyOption {
+	return func(cr *repov1alpha1cluster.RepositoryAccessPolicy) {
+		meta.SetExternalName(cr, n)
+	}
+}
+
+func withAnnotation(k, v string) policyOption {
+	return func(cr *repov1alpha1cluster.RepositoryAccessPolicy) {
+		meta.AddAnnotations(cr, map[string]string{k: v})
+	}
+}
+
+func withGrants(g ...repov1alpha1cluster.RepositoryGrant) policyOption {
+	return func(cr *repov1alpha1cluster.RepositoryAccessPolicy) {
+		cr.Spec.ForProvider.Grants = append([]repov1alpha1cluster.RepositoryGrant{}, g...)
+	}
+}
+
+func withExclusive() policyOption {
+	return func(cr *repov1alpha1cluster.RepositoryAccessPolicy) {
+		cr.Spec.ForProvider.Exclusive = true
+	}
+}
+
+func withUnmanaged(ids ...string) policyOption {
+	return func(cr *repov1alpha1cluster.RepositoryAccessPolicy) {
+		for _, id := range ids {
+			cr.Status.AtProvider.UnmanagedGrants = append(cr.Status.AtProvider.UnmanagedGrants, repov1alpha1cluster.ObservedRepositoryGrant{TeamID: id, Permission: "read"})
+		}
+	}
+}
+
+func policy(o ...policyOption) *repov1alpha1cluster.RepositoryAccessPolicy {
+	cr := &repov1alpha1cluster.RepositoryAccessPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+		Spec: repov1alpha1cluster.RepositoryAccessPolicySpec{
+			ForProvider: repov1alpha1cluster.RepositoryAccessPolicyParameters{
+				OrganizationName: "acme",
+				Repository:       ptr.To("repo"),
+			},
+		},
+	}
+	for _, fn := range o {
+		fn(cr)
+	}
+	return cr
+}
+
+func grant(team, permission string) repov1alpha1cluster.RepositoryGrant {
+	return repov1alpha1cluster.RepositoryGrant{TeamID: ptr.To(team), Permission: permission}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o      managed.ExternalObservation
+		grants []repov1alpha1cluster.RepositoryGrant
+		calls  []string
+		err    error
+	}
+
+	adopt := withAnnotation(upclient.AnnotationKeyAdoptExisting, "true")
+
+	cases := map[string]struct {
+		reason string
+		cr     *repov1alpha1cluster.RepositoryAccessPolicy
+		want   want
+	}{
+		"NotCreated": {
+			reason: "A policy that is not annotated to adopt does not exist until it is created.",
+			cr:     policy(),
+		},
+		"Adopt": {
+			reason: "An annotated policy without grants adopts the grants of its repository before it is created.",
+			cr:     policy(adopt),
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				grants: []repov1alpha1cluster.RepositoryGrant{grant("t1", "read"), grant("t2", "write")},
+				calls:  []string{"get account acme", "list teams", "list t1", "list t2", "list t3", "record repo"},
+			},
+		},
+		"AdoptNothing": {
+			reason: "An annotated policy that lists grants, even none, does not adopt any.",
+			cr:     policy(adopt, withGrants()),
+			want: want{
+				grants: []repov1alpha1cluster.RepositoryGrant{},
+			},
+		},
+		"NeverAdoptAfterCreate": {
+			reason: "A created exclusive policy without grants does not adopt the grants of its repository, but reports them to be revoked.",
+			cr:     policy(adopt, withExternalName("repo"), withExclusive()),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true},
+				calls: []string{"get account acme", "list teams", "list t1", "list t2", "list t3"},
+			},
+		},
+		"ListedTeamsOnly": {
+			reason: "A policy that is not exclusive only lists the permissions of the teams it lists.",
+			cr:     policy(withExternalName("repo"), withGrants(grant("t1", "read"))),
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				grants: []repov1alpha1cluster.RepositoryGrant{grant("t1", "read")},
+				calls:  []string{"list t1"},
+			},
+		},
+		"Drift": {
+			reason: "A listed grant with another permission is not up to date.",
+			cr:     policy(withExternalName("repo"), withGrants(grant("t2", "read"))),
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true},
+				grants: []repov1alpha1cluster.RepositoryGrant{grant("t2", "read")},
+				calls:  []string{"list t2"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			got, err := fake(&calls).Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.grants, tc.cr.Spec.ForProvider.Grants); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want grants, +got grants:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *repov1alpha1cluster.RepositoryAccessPolicy
+		want   []string
+	}{
+		"Exclusive": {
+			reason: "An exclusive policy applies its grants and revokes the unmanaged ones.",
+			cr:     policy(withExternalName("repo"), withExclusive(), withGrants(grant("t1", "read")), withUnmanaged("t2", "gone")),
+			want:   []string{"grant t1 read", "revoke t2", "revoke gone"},
+		},
+		"NotExclusive": {
+			reason: "A policy that is not exclusive keeps the unmanaged grants.",
+			cr:     policy(withExternalName("repo"), withGrants(grant("t1", "read")), withUnmanaged("t2")),
+			want:   []string{"grant t1 read"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			if _, err := fake(&calls).Update(context.Background(), tc.cr); err != nil {
+				t.Fatalf("\n%s\nUpdate(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, calls); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason    string
+		cr        *repov1alpha1cluster.RepositoryAccessPolicy
+		deleteErr error
+		want      []string
+		err       error
+	}{
+		"RevokeListed": {
+			reason: "Only the listed grants are revoked, including those that are gone already.",
+			cr:     policy(withExternalName("repo"), withExclusive(), withGrants(grant("t1", "read"), grant("gone", "read")), withUnmanaged("t2")),
+			want:   []string{"revoke t1", "revoke gone"},
+		},
+		"RevokeFailed": {
+			reason:    "An error revoking a grant is returned.",
+			cr:        policy(withExternalName("repo"), withGrants(grant("t1", "read"))),
+			deleteErr: errBoom,
+			err:       errors.Wrap(errBoom, "cannot revoke repository permission of team t1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := fake(&calls)
+			if tc.deleteErr != nil {
+				e.permissionsCli.(*mockPermissionClient).deleteFn = func(_ context.Context, _ *repositorypermission.GetParameters) error {
+					return tc.deleteErr
+				}
+			}
+			_, err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, calls); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	type want struct {
+		listed    []repov1alpha1cluster.ObservedRepositoryGrant
+		unmanaged []repov1alpha1cluster.ObservedRepositoryGrant
+		upToDate  bool
+	}
+
+	cases := map[string]struct {
+		desired  []repov1alpha1cluster.RepositoryGrant
+		observed map[string]string
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []repov1alpha1cluster.RepositoryGrant{{TeamID: ptr.To("a"), Permission: "read"}},
+			observed: map[string]string{"a": "read"},
+			want: want{
+				listed:   []repov1alpha1cluster.ObservedRepositoryGrant{{TeamID: "a", Permission: "read"}},
+				upToDate: true,
+			},
+		},
+		"Missing": {
+			desired:  []repov1alpha1cluster.RepositoryGrant{{TeamID: ptr.To("a"), Permission: "read"}},
+			observed: map[string]string{},
+			want:     want{},
+		},
+		"DifferentPermission": {
+			desired:  []repov1alpha1cluster.RepositoryGrant{{TeamID: ptr.To("a"), Permission: "write"}},
+			observed: map[string]string{"a": "read"},
+			want: want{
+				listed: []repov1alpha1cluster.ObservedRepositoryGrant{{TeamID: "a", Permission: "read"}},
+			},
+		},
+		"Unmanaged": {
+			desired:  []repov1alpha1cluster.RepositoryGrant{{TeamID: ptr.To("a"), Permission: "read"}},
+			observed: map[string]string{"a": "read", "c": "admin", "b": "view"},
+			want: want{
+				listed: []repov1alpha1cluster.ObservedRepositoryGrant{{TeamID: "a", Permission: "read"}},
+				unmanaged: []repov1alpha1cluster.ObservedRepositoryGrant{
+					{TeamID: "b", Permission: "view"},
+					{TeamID: "c", Permission: "admin"},
+				},
+				upToDate: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			listed, unmanaged, upToDate := compare(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.listed, listed); diff != "" {
+				t.Errorf("compare(...): -want listed, +got listed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.unmanaged, unmanaged); diff != "" {
+				t.Errorf("compare(...): -want unmanaged, +got unmanaged:\n%s", diff)
+			}
+			if upToDate != tc.want.upToDate {
+				t.Errorf("compare(...): want upToDate %t, got %t", tc.want.upToDate, upToDate)
+			}
+		})
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package repositorypermission
 
 import (
+	"cmp"
 	"context"
+	"strings"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
@@ -63,13 +65,14 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
-	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	cfg, profile, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
 	return &external{
 		repositorypermission: repositorypermission.NewClient(cfg),
+		organization:         profile.Account,
 	}, nil
 }
 
@@ -84,6 +87,10 @@ type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an Upbound SDK client.
 	repositorypermission *repositorypermission.Client
+
+	// organization is the organization of the ProviderConfig, which
+	// permissions belong to unless they specify one.
+	organization string
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -92,19 +99,37 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotPermission)
 	}
 
-	err := c.repositorypermission.Get(ctx, &repositorypermission.GetParameters{
-		Repository:   ptr.Deref(cr.Spec.ForProvider.Repository, ""),
-		Organization: cr.Spec.ForProvider.OrganizationName,
-		TeamID:       ptr.Deref(cr.Spec.ForProvider.TeamID, ""),
+	// The external name is of the form <organization>/<repository>/<teamID>.
+	// An imported permission may only have its external name set, in which
+	// case the spec is late-initialized from it.
+	li, err := lateInitialize(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	org := c.organizationName(cr)
+	repo := ptr.Deref(cr.Spec.ForProvider.Repository, "")
+	tid := ptr.Deref(cr.Spec.ForProvider.TeamID, "")
+	err = c.repositorypermission.Get(ctx, &repositorypermission.GetParameters{
+		Repository:   repo,
+		Organization: org,
+		TeamID:       tid,
 	})
 
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "failed to get team")
 	}
+	if en := repositorypermission.ExternalName(org, repo, tid); meta.GetExternalName(cr) != en {
+		// Permissions created before the external name was composite are
+		// named after their repository and renamed once they are observed.
+		meta.SetExternalName(cr, en)
+		li = true
+	}
 	cr.Status.SetConditions(v1.Available())
+	cr.Status.AtProvider.OrganizationName = org
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: li,
 	}, nil
 }
 
@@ -116,7 +141,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	err := c.repositorypermission.Create(ctx, &repositorypermission.CreateParameters{
 		Repository:   ptr.Deref(cr.Spec.ForProvider.Repository, ""),
-		Organization: cr.Spec.ForProvider.OrganizationName,
+		Organization: c.organizationName(cr),
 		TeamID:       ptr.Deref(cr.Spec.ForProvider.TeamID, ""),
 		Permission:   cr.Spec.ForProvider.Permission,
 	})
@@ -124,7 +149,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create repository permission")
 	}
 
-	meta.SetExternalName(cr, ptr.Deref(cr.Spec.ForProvider.Repository, ""))
+	meta.SetExternalName(cr, repositorypermission.ExternalName(c.organizationName(cr), ptr.Deref(cr.Spec.ForProvider.Repository, ""), ptr.Deref(cr.Spec.ForProvider.TeamID, "")))
 
 	return managed.ExternalCreation{}, nil
 }
@@ -141,9 +166,40 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	err := c.repositorypermission.Delete(ctx, &repositorypermission.GetParameters{
 		Repository:   ptr.Deref(cr.Spec.ForProvider.Repository, ""),
-		Organization: cr.Spec.ForProvider.OrganizationName,
+		Organization: c.organizationName(cr),
 		TeamID:       ptr.Deref(cr.Spec.ForProvider.TeamID, ""),
 	})
 	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot delete repositroy permission")
+}
 
+// organizationName returns the name of the organization of the supplied
+// Permission, which defaults to the organization of the ProviderConfig.
+func (c *external) organizationName(cr *repov1alpha1cluster.Permission) string {
+	return cmp.Or(cr.Spec.ForProvider.OrganizationName, c.organization)
+}
+
+// lateInitialize sets the organization, repository and team ID of the
+// supplied Permission from its external name if they are unset. It returns
+// true if any of them was set.
+func lateInitialize(cr *repov1alpha1cluster.Permission) (bool, error) {
+	en := meta.GetExternalName(cr)
+	if en == "" || !strings.Contains(en, "/") {
+		// Legacy external names are the name of the repository.
+		return false, nil
+	}
+	org, repo, tid, err := repositorypermission.ParseExternalName(en)
+	if err != nil {
+		return false, err
+	}
+	p := &cr.Spec.ForProvider
+	if (p.OrganizationName != "" && p.OrganizationName != org) ||
+		(p.Repository != nil && *p.Repository != repo) ||
+		(p.TeamID != nil && *p.TeamID != tid) {
+		return false, errors.Errorf("external name %q does not match the organizationName, repository and teamId in spec", en)
+	}
+	li := p.OrganizationName == "" || p.Repository == nil || p.TeamID == nil
+	p.OrganizationName = org
+	p.Repository = ptr.To(repo)
+	p.TeamID = ptr.To(tid)
+	return li, nil
 }
@@ -0,0 +1,39 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package robot
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+)
+
+// RobotClient manages robots.
+type RobotClient interface {
+	Create(ctx context.Context, params *robots.RobotCreateParameters) (*robots.RobotResponse, error)
+	Get(ctx context.Context, id uuid.UUID) (*robots.RobotResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// OrganizationClient looks up organizations and their robots.
+type OrganizationClient interface {
+	GetOrgID(ctx context.Context, name string) (uint, error)
+	ListRobots(ctx context.Context, id uint) ([]organizations.Robot, error)
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package robot
 
 import (
+	"cmp"
 	"context"
+	"fmt"
 	"strconv"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -50,6 +52,8 @@ const (
 type connector struct {
 	kube  client.Client
 	usage *resource.LegacyProviderConfigUsageTracker
+
+	requireAdoptionAnnotation bool
 }
 
 // Connect typically produces an ExternalClient by:
@@ -67,14 +71,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
-	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	cfg, profile, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
 	return &external{
-		robots:        robots.NewClient(cfg),
-		organizations: organizations.NewClient(cfg),
+		robots:                    robots.NewClient(cfg),
+		organizations:             organizations.NewClient(cfg),
+		annotations:               managed.NewRetryingCriticalAnnotationUpdater(c.kube),
+		requireAdoptionAnnotation: c.requireAdoptionAnnotation,
+		organization:              profile.Account,
 	}, nil
 }
 
@@ -86,8 +93,16 @@ func (e *external) Disconnect(_ context.Context) error {
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	robots        *robots.Client
-	organizations *organizations.Client
+	robots        RobotClient
+	organizations OrganizationClient
+
+	// annotations persists the external name of an adopted robot.
+	annotations               managed.CriticalAnnotationUpdater
+	requireAdoptionAnnotation bool
+
+	// organization is the organization of the ProviderConfig, which owns
+	// robots unless they specify one.
+	organization string
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -97,7 +112,10 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{}, nil
+		adopted, err := c.adopt(ctx, cr)
+		if err != nil || !adopted {
+			return managed.ExternalObservation{}, err
+		}
 	}
 	id, err := uuid.Parse(meta.GetExternalName(cr))
 	if err != nil {
@@ -109,10 +127,12 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	cr.Status.SetConditions(v1.Available())
 	cr.Status.AtProvider.ID = resp.ID.String()
+	cr.Status.AtProvider.OrganizationID = upclient.RelationshipID(resp.RelationshipSet, "owner")
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialize(&cr.Spec.ForProvider, resp),
 	}, nil
 }
 
@@ -121,16 +141,9 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotRobot)
 	}
-	id := ptr.Deref(cr.Spec.ForProvider.Owner.ID, "")
-	if id == "" {
-		if cr.Spec.ForProvider.Owner.Name == nil {
-			return managed.ExternalCreation{}, errors.New("organization name or id must be specified")
-		}
-		o, err := c.organizations.GetOrgID(ctx, *cr.Spec.ForProvider.Owner.Name)
-		if err != nil {
-			return managed.ExternalCreation{}, errors.Wrap(err, "cannot get organization id")
-		}
-		id = strconv.FormatUint(uint64(o), 10)
+	orgID, err := c.organizationID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
 	}
 
 	resp, err := c.robots.Create(ctx, &robots.RobotCreateParameters{
@@ -142,7 +155,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			Owner: robots.RobotOwner{
 				Data: robots.RobotOwnerData{
 					Type: robots.RobotOwnerOrganization,
-					ID:   id,
+					ID:   strconv.FormatUint(uint64(orgID), 10),
 				},
 			},
 		},
@@ -171,3 +184,70 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.robots.Delete(ctx, id)), "cannot delete robot")
 }
+
+// organizationID returns the ID of the organization that owns the supplied
+// Robot, which defaults to the organization of the ProviderConfig.
+func (c *external) organizationID(ctx context.Context, cr *iamv1alpha1cluster.Robot) (uint, error) {
+	if id := ptr.Deref(cr.Spec.ForProvider.Owner.ID, ""); id != "" {
+		o, err := strconv.ParseUint(id, 10, 0)
+		return uint(o), errors.Wrap(err, "cannot parse organization id")
+	}
+	name := cmp.Or(ptr.Deref(cr.Spec.ForProvider.Owner.Name, ""), c.organization)
+	if name == "" {
+		return 0, errors.New("organization name or id must be specified")
+	}
+	o, err := c.organizations.GetOrgID(ctx, name)
+	return o, errors.Wrap(err, "cannot get organization id")
+}
+
+// adopt looks for an existing robot with the name of the supplied Robot in
+// its organization before one is created. This prevents duplicates when a
+// manifest is applied again, e.g. after an etcd restore, or when the external
+// name of a created robot was not persisted. The robot is adopted only when
+// adoption is allowed; otherwise the Robot reports a conflict instead of
+// creating a duplicate.
+func (c *external) adopt(ctx context.Context, cr *iamv1alpha1cluster.Robot) (bool, error) {
+	if meta.WasDeleted(cr) {
+		// Never adopt a resource only to delete it.
+		return false, nil
+	}
+	orgID, err := c.organizationID(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	list, err := c.organizations.ListRobots(ctx, orgID)
+	if err != nil {
+		return false, errors.Wrap(err, "cannot list robots")
+	}
+	var found []organizations.Robot
+	for _, rb := range list {
+		if rb.Name == cr.Spec.ForProvider.Name {
+			found = append(found, rb)
+		}
+	}
+	if len(found) == 0 {
+		return false, nil
+	}
+	if !upclient.AdoptionAllowed(cr, c.requireAdoptionAnnotation) {
+		msg := fmt.Sprintf("robot %s already exists in organization %d, annotate with %s: \"true\" to adopt it", cr.Spec.ForProvider.Name, orgID, upclient.AnnotationKeyAdoptExisting)
+		cr.Status.SetConditions(upclient.Conflict(msg))
+		return false, errors.New(msg)
+	}
+	if len(found) > 1 {
+		msg := fmt.Sprintf("%d robots named %s exist in organization %d, set the external name to the ID of the one to adopt", len(found), cr.Spec.ForProvider.Name, orgID)
+		cr.Status.SetConditions(upclient.Conflict(msg))
+		return false, errors.New(msg)
+	}
+	meta.SetExternalName(cr, found[0].ID.String())
+	return true, errors.Wrap(c.annotations.UpdateCriticalAnnotations(ctx, cr), "cannot record adopted robot")
+}
+
+// lateInitialize sets the unset fields of the supplied parameters from the
+// supplied robot. It returns true if any of them was set.
+func lateInitialize(p *iamv1alpha1cluster.RobotParameters, resp *robots.RobotResponse) bool {
+	name, _ := resp.AttributeSet["name"].(string)
+	description, _ := resp.AttributeSet["description"].(string)
+	li := upclient.LateInitializeString(&p.Name, name)
+	li = upclient.LateInitializeString(&p.Description, description) || li
+	return upclient.LateInitialize(&p.Owner.ID, upclient.RelationshipID(resp.RelationshipSet, "owner")) || li
+}
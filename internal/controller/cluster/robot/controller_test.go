@@ -0,0 +1,175 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package robot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+
+	iamv1alpha1 "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+)
+
+var (
+	existingID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherID    = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+)
+
+// robot returns a Robot named ci in organization 1 with the supplied
+// annotations.
+func robot(annotations map[string]string) *iamv1alpha1.Robot {
+	return &iamv1alpha1.Robot{
+		ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+		Spec: iamv1alpha1.RobotSpec{ForProvider: iamv1alpha1.RobotParameters{
+			Name:  "ci",
+			Owner: iamv1alpha1.RobotOwner{ID: ptr.To("1")},
+		}},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		externalName string
+		reason       xpv1.ConditionReason
+		err          error
+	}
+
+	conflict := `robot ci already exists in organization 1, annotate with upbound.io/adopt-existing: "true" to adopt it`
+	incomplete := robot(nil)
+	meta.SetExternalCreatePending(incomplete, time.Now())
+
+	cases := map[string]struct {
+		reason  string
+		require bool
+		robots  []organizations.Robot
+		mg      *iamv1alpha1.Robot
+		want    want
+	}{
+		"NotFound": {
+			reason: "A Robot without an external name does not exist when no robot has its name.",
+			robots: []organizations.Robot{{ID: otherID, Name: "release"}},
+			mg:     robot(nil),
+		},
+		"AdoptByName": {
+			reason:  "A Robot annotated to adopt takes over the robot with its name.",
+			require: true,
+			robots:  []organizations.Robot{{ID: otherID, Name: "release"}, {ID: existingID, Name: "ci"}},
+			mg:      robot(map[string]string{upclient.AnnotationKeyAdoptExisting: "true"}),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: existingID.String(),
+				reason:       xpv1.ReasonAvailable,
+			},
+		},
+		"AdoptionConflict": {
+			reason:  "A Robot that may not adopt reports a conflict instead of creating a duplicate.",
+			require: true,
+			robots:  []organizations.Robot{{ID: existingID, Name: "ci"}},
+			mg:      robot(nil),
+			want: want{
+				reason: upclient.ReasonConflict,
+				err:    errors.New(conflict),
+			},
+		},
+		"AdoptionOptedOut": {
+			reason: "A Robot that opts out of adoption reports a conflict even when no annotation is required.",
+			robots: []organizations.Robot{{ID: existingID, Name: "ci"}},
+			mg:     robot(map[string]string{upclient.AnnotationKeyAdoptExisting: "false"}),
+			want: want{
+				reason: upclient.ReasonConflict,
+				err:    errors.New(conflict),
+			},
+		},
+		"CreateIncomplete": {
+			reason:  "A Robot whose creation result was lost adopts the robot it likely created without an annotation.",
+			require: true,
+			robots:  []organizations.Robot{{ID: existingID, Name: "ci"}},
+			mg:      incomplete,
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: existingID.String(),
+				reason:       xpv1.ReasonAvailable,
+			},
+		},
+		"Ambiguous": {
+			reason: "A Robot does not guess which of several robots with its name to adopt.",
+			robots: []organizations.Robot{{ID: existingID, Name: "ci"}, {ID: otherID, Name: "ci"}},
+			mg:     robot(nil),
+			want: want{
+				reason: upclient.ReasonConflict,
+				err:    errors.New("2 robots named ci exist in organization 1, set the external name to the ID of the one to adopt"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var recorded string
+			e := &external{
+				robots: &mockRobotClient{
+					getFn: func(_ context.Context, id uuid.UUID) (*robots.RobotResponse, error) {
+						resp := &robots.RobotResponse{}
+						resp.ID = id
+						return resp, nil
+					},
+				},
+				organizations: &mockOrganizationClient{
+					listRobotsFn: func(_ context.Context, id uint) ([]organizations.Robot, error) {
+						if id != 1 {
+							return nil, errors.Errorf("unexpected organization %d", id)
+						}
+						return tc.robots, nil
+					},
+				},
+				annotations: managed.CriticalAnnotationUpdateFn(func(_ context.Context, o client.Object) error {
+					recorded = meta.GetExternalName(o)
+					return nil
+				}),
+				requireAdoptionAnnotation: tc.require,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, recorded); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want recorded external name, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, tc.mg.GetCondition(xpv1.TypeReady).Reason); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want ready reason, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package robot
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+)
+
+type mockRobotClient struct {
+	createFn func(ctx context.Context, params *robots.RobotCreateParameters) (*robots.RobotResponse, error)
+	getFn    func(ctx context.Context, id uuid.UUID) (*robots.RobotResponse, error)
+	deleteFn func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockRobotClient) Create(ctx context.Context, params *robots.RobotCreateParameters) (*robots.RobotResponse, error) {
+	return m.createFn(ctx, params)
+}
+
+func (m *mockRobotClient) Get(ctx context.Context, id uuid.UUID) (*robots.RobotResponse, error) {
+	return m.getFn(ctx, id)
+}
+
+func (m *mockRobotClient) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.deleteFn(ctx, id)
+}
+
+type mockOrganizationClient struct {
+	getOrgIDFn   func(ctx context.Context, name string) (uint, error)
+	listRobotsFn func(ctx context.Context, id uint) ([]organizations.Robot, error)
+}
+
+func (m *mockOrganizationClient) GetOrgID(ctx context.Context, name string) (uint, error) {
+	return m.getOrgIDFn(ctx, name)
+}
+
+func (m *mockOrganizationClient) ListRobots(ctx context.Context, id uint) ([]organizations.Robot, error) {
+	return m.listRobotsFn(ctx, id)
+}
@@ -45,10 +45,18 @@ func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	name := managed.ControllerName(iamv1alpha1cluster.RobotGroupKind)
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnector(&connector{
-			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1cluster.ProviderConfigUsage{}),
+			kube:                      mgr.GetClient(),
+			usage:                     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1cluster.ProviderConfigUsage{}),
+			requireAdoptionAnnotation: o.Features.Enabled(features.RequireAdoptionAnnotation),
 		}),
 		managed.WithPollInterval(o.PollInterval),
+		// Robot IDs are assigned by the API, so the external name is not
+		// deterministic. The option bypasses the ExternalCreateIncomplete
+		// protection, which would require removing the creation-pending
+		// annotation by hand after a lost creation result. Observe looks the
+		// robot up by name instead, and a Robot whose creation is incomplete
+		// always adopts it rather than creating a duplicate.
+		managed.WithDeterministicExternalName(true),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithInitializers(),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
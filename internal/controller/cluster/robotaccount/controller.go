@@ -0,0 +1,456 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package robotaccount
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/tokens"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
+	"github.com/upbound/provider-upbound/internal/client/token"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+)
+
+const (
+	errNotRobotAccount = "managed resource is not a RobotAccount custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errNewClient       = "cannot create new client"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage *resource.LegacyProviderConfigUsageTracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.RobotAccount)
+	if !ok {
+		return nil, errors.New(errNotRobotAccount)
+	}
+
+	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		robots:        robots.NewClient(cfg),
+		tokens:        tokens.NewClient(cfg),
+		organizations: organizations.NewClient(cfg),
+		memberships:   robotteammembership.NewClient(cfg),
+		secrets:       token.NewSecretPublisher(c.kube),
+		profiles:      token.NewProfiler(c.kube, config.GetProviderConfigSpecFn(cr)),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	robots        RobotClient
+	tokens        TokenClient
+	organizations OrganizationClient
+	memberships   MembershipClient
+
+	// secrets writes the token to the output Secret, whose type depends on
+	// the output format.
+	secrets SecretClient
+
+	// profiles logs in with the credentials when they are written as an up
+	// CLI profile.
+	profiles token.Profiler
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.RobotAccount)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRobotAccount)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	id, err := uuid.Parse(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot parse external name as a uuid")
+	}
+	robot, err := c.robots.Get(ctx, id)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get robot")
+	}
+	tokenID, name, err := c.token(ctx, cr, id)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	teamIDs, err := c.teamIDs(ctx, cr, id)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = iamv1alpha1cluster.RobotAccountObservation{
+		RobotID:   id.String(),
+		TokenID:   tokenID,
+		TokenName: name,
+		TeamIDs:   teamIDs,
+	}
+
+	// The token is only returned on creation, so it is read back from the
+	// Secrets it was written to in order to apply format changes.
+	var cd managed.ConnectionDetails
+	lost := false
+	if tokenID != "" {
+		creds, ok, err := c.secrets.Lookup(ctx, tokenID, credentialSources(cr)...)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, "cannot look up token credentials")
+		}
+		lost = !ok && len(credentialSources(cr)) > 0
+		if ok {
+			if cd, err = token.ConnectionDetails(ctx, outputFormat(cr), creds, c.profiles); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, "cannot format token credentials")
+			}
+			if err := c.publishOutput(ctx, cr, creds); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, "cannot publish token output")
+			}
+		}
+	}
+	cr.Status.SetConditions(v1.Available())
+
+	description, _ := robot.AttributeSet["description"].(string)
+	li := upclient.LateInitializeString(&cr.Spec.ForProvider.Description, description)
+
+	add, remove := teamChanges(cr.Spec.ForProvider.Teams, teamIDs)
+	return managed.ExternalObservation{
+		ConnectionDetails:       cd,
+		ResourceExists:          true,
+		ResourceUpToDate:        tokenID != "" && name == tokenName(cr) && !lost && len(add) == 0 && len(remove) == 0,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+// Create creates the robot and its token. The team memberships are added by
+// the Update that follows, so that the token is never lost to a failure to
+// add them.
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.RobotAccount)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRobotAccount)
+	}
+	orgID, err := c.organizationID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	resp, err := c.robots.Create(ctx, &robots.RobotCreateParameters{
+		Attributes: robots.RobotAttributes{
+			Name:        cr.Spec.ForProvider.Name,
+			Description: cr.Spec.ForProvider.Description,
+		},
+		Relationships: robots.RobotRelationships{
+			Owner: robots.RobotOwner{
+				Data: robots.RobotOwnerData{
+					Type: robots.RobotOwnerOrganization,
+					ID:   strconv.FormatUint(uint64(orgID), 10),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create robot")
+	}
+	// The external name is persisted even if issuing the token fails, in
+	// which case it is issued by a later Update.
+	meta.SetExternalName(cr, resp.ID.String())
+
+	cd, err := c.issue(ctx, cr, resp.ID.String())
+	return managed.ExternalCreation{ConnectionDetails: cd}, err
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.RobotAccount)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRobotAccount)
+	}
+	robotID := meta.GetExternalName(cr)
+
+	// The token and the team memberships were observed right before the
+	// update. A token that was issued with another name is revoked before
+	// the renamed one is issued so that it does not leak.
+	if id := cr.Status.AtProvider.TokenID; id != "" && cr.Status.AtProvider.TokenName != tokenName(cr) {
+		if err := c.deleteToken(ctx, id); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		cr.Status.AtProvider.TokenID = ""
+	}
+	// A token that none of the Secrets hold cannot be recovered, so it is
+	// replaced by a new one.
+	if id := cr.Status.AtProvider.TokenID; id != "" && len(credentialSources(cr)) > 0 {
+		_, ok, err := c.secrets.Lookup(ctx, id, credentialSources(cr)...)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "cannot look up token credentials")
+		}
+		if !ok {
+			if err := c.deleteToken(ctx, id); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+			cr.Status.AtProvider.TokenID = ""
+		}
+	}
+	var cd managed.ConnectionDetails
+	if cr.Status.AtProvider.TokenID == "" {
+		var err error
+		if cd, err = c.issue(ctx, cr, robotID); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	add, remove := teamChanges(cr.Spec.ForProvider.Teams, cr.Status.AtProvider.TeamIDs)
+	for _, id := range add {
+		err := c.memberships.Create(ctx, robotID, &robotteammembership.ResourceIdentifier{
+			ID:   id,
+			Type: robotteammembership.RobotMembershipTypeTeam,
+		})
+		if err != nil {
+			return managed.ExternalUpdate{ConnectionDetails: cd}, errors.Wrapf(err, "cannot add robot to team %s", id)
+		}
+	}
+	for _, id := range remove {
+		if err := c.removeFromTeam(ctx, robotID, id); err != nil {
+			return managed.ExternalUpdate{ConnectionDetails: cd}, err
+		}
+	}
+	return managed.ExternalUpdate{ConnectionDetails: cd}, nil
+}
+
+// Delete tears down the team memberships, the token and the robot in the
+// reverse order of their creation.
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.RobotAccount)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotRobotAccount)
+	}
+	robotID := meta.GetExternalName(cr)
+
+	for _, id := range cr.Status.AtProvider.TeamIDs {
+		if err := c.removeFromTeam(ctx, robotID, id); err != nil {
+			return managed.ExternalDelete{}, err
+		}
+	}
+	if tokenID := cr.Status.AtProvider.TokenID; tokenID != "" {
+		if err := c.deleteToken(ctx, tokenID); err != nil {
+			return managed.ExternalDelete{}, err
+		}
+	}
+	id, err := uuid.Parse(robotID)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, "cannot parse external name as a uuid")
+	}
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.robots.Delete(ctx, id)), "cannot delete robot")
+}
+
+// issue issues a token for the robot with the supplied ID and writes it to
+// the output Secret of the supplied RobotAccount. It returns the connection
+// details holding the token.
+func (c *external) issue(ctx context.Context, cr *iamv1alpha1cluster.RobotAccount, robotID string) (managed.ConnectionDetails, error) {
+	resp, err := c.tokens.Create(ctx, &tokens.TokenCreateParameters{
+		Attributes: tokens.TokenAttributes{
+			Name: tokenName(cr),
+		},
+		Relationships: tokens.TokenRelationships{
+			Owner: tokens.TokenOwner{
+				Data: tokens.TokenOwnerData{
+					Type: tokens.TokenOwnerRobot,
+					ID:   robotID,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create token")
+	}
+	creds := token.CredentialsFrom(resp)
+	cd, err := token.ConnectionDetails(ctx, outputFormat(cr), creds, c.profiles)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot format token credentials")
+	}
+	return cd, errors.Wrap(c.publishOutput(ctx, cr, creds), "cannot publish token output")
+}
+
+// deleteToken deletes the token with the supplied ID, if it still exists.
+func (c *external) deleteToken(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return errors.Wrap(err, "cannot parse token ID as a uuid")
+	}
+	return errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.tokens.Delete(ctx, uid)), "cannot delete token")
+}
+
+// removeFromTeam removes the robot with the supplied ID from the team with
+// the supplied ID, if it is still a member.
+func (c *external) removeFromTeam(ctx context.Context, robotID, teamID string) error {
+	err := c.memberships.Delete(ctx, robotID, &robotteammembership.DeleteParameters{
+		ID:   teamID,
+		Type: robotteammembership.RobotMembershipTypeTeam,
+	})
+	return errors.Wrapf(resource.Ignore(uperrors.IsNotFound, err), "cannot remove robot from team %s", teamID)
+}
+
+// token returns the ID and name of the token of the supplied RobotAccount
+// that is owned by the robot with the supplied ID, or empty strings if there
+// is none. Tokens are identified by name since the external name of the
+// RobotAccount is the ID of the robot. The token recorded in the status is
+// returned when none has the name, so that a renamed token is not leaked.
+func (c *external) token(ctx context.Context, cr *iamv1alpha1cluster.RobotAccount, robotID uuid.UUID) (id, name string, err error) {
+	resp, err := c.robots.ListTokens(ctx, robotID)
+	if err != nil {
+		return "", "", errors.Wrap(err, "cannot list robot tokens")
+	}
+	for _, t := range resp.DataSet {
+		n, _ := t.AttributeSet["name"].(string)
+		if n == tokenName(cr) {
+			return t.ID.String(), n, nil
+		}
+		if t.ID.String() == cr.Status.AtProvider.TokenID {
+			id, name = t.ID.String(), n
+		}
+	}
+	return id, name, nil
+}
+
+// teamIDs returns the sorted IDs of the teams the robot with the supplied ID
+// is a member of.
+func (c *external) teamIDs(ctx context.Context, cr *iamv1alpha1cluster.RobotAccount, robotID uuid.UUID) ([]string, error) {
+	orgID, err := c.organizationID(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.organizations.ListRobots(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list robots")
+	}
+	var ids []string
+	for _, rb := range list {
+		if rb.ID != robotID {
+			continue
+		}
+		for _, id := range rb.TeamIDs {
+			ids = append(ids, id.String())
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// organizationID returns the ID of the organization that owns the robot of
+// the supplied RobotAccount.
+func (c *external) organizationID(ctx context.Context, cr *iamv1alpha1cluster.RobotAccount) (uint, error) {
+	if cr.Spec.ForProvider.OrganizationName == nil {
+		return 0, errors.New("organizationName must be specified")
+	}
+	o, err := c.organizations.GetOrgID(ctx, *cr.Spec.ForProvider.OrganizationName)
+	return o, errors.Wrap(err, "cannot get organization id")
+}
+
+// publishOutput writes the supplied credentials to the output Secret of the
+// supplied RobotAccount, if one is configured.
+func (c *external) publishOutput(ctx context.Context, cr *iamv1alpha1cluster.RobotAccount, creds token.Credentials) error {
+	o := cr.Spec.ForProvider.Output
+	if o == nil || o.SecretRef == nil {
+		return nil
+	}
+	ref := types.NamespacedName{Name: o.SecretRef.Name, Namespace: o.SecretRef.Namespace}
+	return c.secrets.Publish(ctx, cr, iamv1alpha1cluster.RobotAccountGroupVersionKind, ref, o.TokenFormat, creds, c.profiles)
+}
+
+// teamChanges returns the IDs of the teams the robot has to be added to and
+// removed from for the observed teams to match the desired ones.
+func teamChanges(desired []iamv1alpha1cluster.RobotAccountTeam, observed []string) (add, remove []string) {
+	want := make(map[string]bool, len(desired))
+	for _, t := range desired {
+		id := ptr.Deref(t.TeamID, "")
+		if id == "" || want[id] {
+			continue
+		}
+		want[id] = true
+		if !slices.Contains(observed, id) {
+			add = append(add, id)
+		}
+	}
+	for _, id := range observed {
+		if !want[id] {
+			remove = append(remove, id)
+		}
+	}
+	return add, remove
+}
+
+// tokenName returns the name of the token of the supplied RobotAccount.
+func tokenName(cr *iamv1alpha1cluster.RobotAccount) string {
+	return ptr.Deref(cr.Spec.ForProvider.TokenName, cr.Spec.ForProvider.Name)
+}
+
+// outputFormat returns the format the token of the supplied RobotAccount is
+// written in.
+func outputFormat(cr *iamv1alpha1cluster.RobotAccount) iamv1alpha1common.TokenFormat {
+	if cr.Spec.ForProvider.Output == nil {
+		return iamv1alpha1common.TokenFormat{}
+	}
+	return cr.Spec.ForProvider.Output.TokenFormat
+}
+
+// credentialSources returns the Secrets the token of the supplied
+// RobotAccount is written to.
+func credentialSources(cr *iamv1alpha1cluster.RobotAccount) []types.NamespacedName {
+	var refs []types.NamespacedName
+	if ref := cr.GetWriteConnectionSecretToReference(); ref != nil {
+		refs = append(refs, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace})
+	}
+	if o := cr.Spec.ForProvider.Output; o != nil && o.SecretRef != nil {
+		refs = append(refs, types.NamespacedName{Name: o.SecretRef.Name, Namespace: o.SecretRef.Namespace})
+	}
+	return refs
+}
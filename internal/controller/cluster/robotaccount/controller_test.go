@@ -0,0 +1,344 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package robotaccount
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/tokens"
+
+	iamv1alpha1 "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
+	"github.com/upbound/provider-upbound/internal/client/token"
+)
+
+const (
+	robotID = "6f1a2c3d-0000-4000-8000-000000000001"
+	tokenID = "6f1a2c3d-0000-4000-8000-000000000002"
+	oldID   = "6f1a2c3d-0000-4000-8000-000000000003"
+)
+
+// recorder returns an external client whose API calls are recorded in the
+// supplied slice. Secrets hold the token with the supplied ID, if any.
+func recorder(calls *[]string, held string) *external {
+	return &external{
+		robots: &mockRobotClient{
+			createFn: func(_ context.Context, _ *robots.RobotCreateParameters) (*robots.RobotResponse, error) {
+				*calls = append(*calls, "create robot")
+				return &robots.RobotResponse{DataSet: common.DataSet{ID: uuid.MustParse(robotID)}}, nil
+			},
+			getFn: func(_ context.Context, id uuid.UUID) (*robots.RobotResponse, error) {
+				return &robots.RobotResponse{DataSet: common.DataSet{ID: id, AttributeSet: common.AttributeSet{"description": "ci"}}}, nil
+			},
+			listTokensFn: func(_ context.Context, _ uuid.UUID) (*tokens.TokensResponse, error) {
+				return &tokens.TokensResponse{DataSet: []common.DataSet{{ID: uuid.MustParse(tokenID), AttributeSet: common.AttributeSet{"name": "ci"}}}}, nil
+			},
+			deleteFn: func(_ context.Context, id uuid.UUID) error {
+				*calls = append(*calls, "delete robot "+id.String())
+				return nil
+			},
+		},
+		tokens: &mockTokenClient{
+			createFn: func(_ context.Context, params *tokens.TokenCreateParameters) (*tokens.TokenResponse, error) {
+				*calls = append(*calls, "create token for "+params.Relationships.Owner.Data.ID)
+				return &tokens.TokenResponse{DataSet: common.DataSet{ID: uuid.MustParse(tokenID), Meta: common.Meta{"jwt": "jwt"}}}, nil
+			},
+			deleteFn: func(_ context.Context, id uuid.UUID) error {
+				*calls = append(*calls, "delete token "+id.String())
+				return nil
+			},
+		},
+		organizations: &mockOrganizationClient{
+			getOrgIDFn: func(_ context.Context, _ string) (uint, error) {
+				return 1, nil
+			},
+			listRobotsFn: func(_ context.Context, _ uint) ([]organizations.Robot, error) {
+				return nil, nil
+			},
+		},
+		memberships: &mockMembershipClient{
+			createFn: func(_ context.Context, robotID string, params *robotteammembership.ResourceIdentifier) error {
+				*calls = append(*calls, fmt.Sprintf("add robot %s to team %s", robotID, params.ID))
+				return nil
+			},
+			deleteFn: func(_ context.Context, robotID string, params *robotteammembership.DeleteParameters) error {
+				*calls = append(*calls, fmt.Sprintf("remove robot %s from team %s", robotID, params.ID))
+				return nil
+			},
+		},
+		secrets: &mockSecretClient{
+			lookupFn: func(_ context.Context, id string, _ ...types.NamespacedName) (token.Credentials, bool, error) {
+				return token.Credentials{ID: id, Token: "jwt"}, id == held, nil
+			},
+		},
+	}
+}
+
+func robotAccount(o ...func(*iamv1alpha1.RobotAccount)) *iamv1alpha1.RobotAccount {
+	cr := &iamv1alpha1.RobotAccount{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyExternalName: robotID}},
+		Spec: iamv1alpha1.RobotAccountSpec{ForProvider: iamv1alpha1.RobotAccountParameters{
+			Name:             "ci",
+			Description:      "ci",
+			OrganizationName: ptr.To("org"),
+		}},
+	}
+	for _, fn := range o {
+		fn(cr)
+	}
+	return cr
+}
+
+func withConnectionSecret(cr *iamv1alpha1.RobotAccount) {
+	cr.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "conn", Namespace: "default"})
+}
+
+// withTokenID records the token with the supplied ID, issued with the
+// default name.
+func withTokenID(id string) func(*iamv1alpha1.RobotAccount) {
+	return func(cr *iamv1alpha1.RobotAccount) {
+		cr.Status.AtProvider.TokenID = id
+		cr.Status.AtProvider.TokenName = "ci"
+	}
+}
+
+func withTokenName(name string) func(*iamv1alpha1.RobotAccount) {
+	return func(cr *iamv1alpha1.RobotAccount) {
+		cr.Spec.ForProvider.TokenName = ptr.To(name)
+	}
+}
+
+func withTeamIDs(ids ...string) func(*iamv1alpha1.RobotAccount) {
+	return func(cr *iamv1alpha1.RobotAccount) {
+		cr.Status.AtProvider.TeamIDs = ids
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o       managed.ExternalObservation
+		tokenID string
+	}
+
+	cases := map[string]struct {
+		mg   *iamv1alpha1.RobotAccount
+		held string
+		want want
+	}{
+		"TokenHeld": {
+			mg:   robotAccount(withConnectionSecret),
+			held: tokenID,
+			want: want{
+				o: managed.ExternalObservation{
+					ConnectionDetails: managed.ConnectionDetails{token.KeyToken: []byte("jwt"), token.KeyID: []byte(tokenID)},
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+				},
+				tokenID: tokenID,
+			},
+		},
+		"TokenLost": {
+			mg: robotAccount(withConnectionSecret),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+				tokenID: tokenID,
+			},
+		},
+		"NoSecrets": {
+			mg: robotAccount(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				tokenID: tokenID,
+			},
+		},
+		"TokenRenamed": {
+			mg:   robotAccount(withConnectionSecret, withTokenID(tokenID), withTokenName("deploy")),
+			held: tokenID,
+			want: want{
+				o: managed.ExternalObservation{
+					ConnectionDetails: managed.ConnectionDetails{token.KeyToken: []byte("jwt"), token.KeyID: []byte(tokenID)},
+					ResourceExists:    true,
+				},
+				tokenID: tokenID,
+			},
+		},
+		"TokenRenamedGone": {
+			mg: robotAccount(withTokenID(oldID), withTokenName("deploy")),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			got, err := recorder(&calls, tc.held).Observe(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Observe(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tokenID, tc.mg.Status.AtProvider.TokenID); diff != "" {
+				t.Errorf("Observe(...): -want token ID, +got token ID:\n%s", diff)
+			}
+			if diff := cmp.Diff([]string(nil), calls); diff != "" {
+				t.Errorf("Observe(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var calls []string
+	cr := robotAccount()
+	meta.SetExternalName(cr, "")
+	got, err := recorder(&calls, "").Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	want := managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{token.KeyToken: []byte("jwt"), token.KeyID: []byte(tokenID)}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"create robot", "create token for " + robotID}, calls); diff != "" {
+		t.Errorf("Create(...): -want calls, +got calls:\n%s", diff)
+	}
+	if diff := cmp.Diff(robotID, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Create(...): -want external name, +got external name:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		mg   *iamv1alpha1.RobotAccount
+		held string
+		want []string
+	}{
+		"IssueMissingToken": {
+			mg:   robotAccount(withConnectionSecret),
+			want: []string{"create token for " + robotID},
+		},
+		"ReissueLostToken": {
+			mg:   robotAccount(withConnectionSecret, withTokenID(oldID)),
+			want: []string{"delete token " + oldID, "create token for " + robotID},
+		},
+		"KeepHeldToken": {
+			mg:   robotAccount(withConnectionSecret, withTokenID(tokenID)),
+			held: tokenID,
+		},
+		"RevokeRenamedToken": {
+			mg:   robotAccount(withConnectionSecret, withTokenID(tokenID), withTokenName("deploy")),
+			held: tokenID,
+			want: []string{"delete token " + tokenID, "create token for " + robotID},
+		},
+		"KeepTokenWithoutSecrets": {
+			mg: robotAccount(withTokenID(tokenID)),
+		},
+		"ChangeTeams": {
+			mg: robotAccount(withTokenID(tokenID), withTeamIDs("b"), func(cr *iamv1alpha1.RobotAccount) {
+				cr.Spec.ForProvider.Teams = []iamv1alpha1.RobotAccountTeam{{TeamID: ptr.To("a")}}
+			}),
+			want: []string{"add robot " + robotID + " to team a", "remove robot " + robotID + " from team b"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			if _, err := recorder(&calls, tc.held).Update(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Update(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		mg   *iamv1alpha1.RobotAccount
+		want []string
+	}{
+		"TeardownInReverse": {
+			mg: robotAccount(withTokenID(tokenID), withTeamIDs("a", "b")),
+			want: []string{
+				"remove robot " + robotID + " from team a",
+				"remove robot " + robotID + " from team b",
+				"delete token " + tokenID,
+				"delete robot " + robotID,
+			},
+		},
+		"NoToken": {
+			mg:   robotAccount(),
+			want: []string{"delete robot " + robotID},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			if _, err := recorder(&calls, "").Delete(context.Background(), tc.mg); err != nil {
+				t.Fatalf("Delete(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, calls); diff != "" {
+				t.Errorf("Delete(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	notFound := &uperrors.Error{Status: http.StatusNotFound}
+	e := &external{
+		robots: &mockRobotClient{deleteFn: func(_ context.Context, _ uuid.UUID) error { return notFound }},
+		tokens: &mockTokenClient{deleteFn: func(_ context.Context, _ uuid.UUID) error { return notFound }},
+		memberships: &mockMembershipClient{deleteFn: func(_ context.Context, _ string, _ *robotteammembership.DeleteParameters) error {
+			return notFound
+		}},
+	}
+	_, err := e.Delete(context.Background(), robotAccount(withTokenID(tokenID), withTeamIDs("a")))
+	if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
@@ -90,19 +91,30 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotRobotTeamMembership)
 	}
 
-	// NOTE(muvaf): External name annotation is not used since this is an attribute
-	// that binds to resources and the id of both are already under spec.
-
+	// The external name is of the form <robotID>/<teamID>. An imported
+	// membership may only have its external name set, in which case the IDs
+	// in spec are late-initialized from it.
+	li, err := lateInitialize(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	tid := ptr.Deref(cr.Spec.ForProvider.TeamID, "")
 	rid := ptr.Deref(cr.Spec.ForProvider.RobotID, "")
 	if err := c.robotTeamMemberships.Get(ctx, rid, tid); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get robot team ids")
 	}
+	if en := robotteammembership.ExternalName(rid, tid); meta.GetExternalName(cr) != en {
+		// Memberships created before the external name was set are named
+		// once they are observed.
+		meta.SetExternalName(cr, en)
+		li = true
+	}
 	cr.Status.SetConditions(v1.Available())
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: li,
 	}, nil
 }
 
@@ -119,6 +131,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create team membership for the robot")
 	}
+	meta.SetExternalName(cr, robotteammembership.ExternalName(rid, tid))
 	return managed.ExternalCreation{}, nil
 }
 
@@ -138,3 +151,25 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	})
 	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot delete robot team membership")
 }
+
+// lateInitialize sets the robot and team IDs of the supplied
+// RobotTeamMembership from its external name if they are unset. It returns
+// true if any of them was set.
+func lateInitialize(cr *iamv1alpha1cluster.RobotTeamMembership) (bool, error) {
+	en := meta.GetExternalName(cr)
+	if en == "" {
+		return false, nil
+	}
+	rid, tid, err := robotteammembership.ParseExternalName(en)
+	if err != nil {
+		return false, err
+	}
+	p := &cr.Spec.ForProvider
+	if (p.RobotID != nil && *p.RobotID != rid) || (p.TeamID != nil && *p.TeamID != tid) {
+		return false, errors.Errorf("external name %q does not match the robotId and teamId in spec", en)
+	}
+	li := p.RobotID == nil || p.TeamID == nil
+	p.RobotID = ptr.To(rid)
+	p.TeamID = ptr.To(tid)
+	return li, nil
+}
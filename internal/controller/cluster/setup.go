@@ -21,12 +21,23 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/configuration"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/controlplane"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/controlplanepermission"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/organization"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/organizationinvite"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/organizationmember"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/pullsecretdistribution"
 	"github.com/upbound/provider-upbound/internal/controller/cluster/repository"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/repositoryaccesspolicy"
 	"github.com/upbound/provider-upbound/internal/controller/cluster/repositorypermission"
 	"github.com/upbound/provider-upbound/internal/controller/cluster/robot"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/robotaccount"
 	"github.com/upbound/provider-upbound/internal/controller/cluster/robotteammembership"
 	"github.com/upbound/provider-upbound/internal/controller/cluster/team"
 	"github.com/upbound/provider-upbound/internal/controller/cluster/token"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/user"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/userteammembership"
 )
 
 // Setup creates all Upbound controllers related to cluster-scoped MRs
@@ -34,12 +45,23 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		config.SetupGated,
+		configuration.SetupGated,
+		controlplane.SetupGated,
+		controlplanepermission.SetupGated,
+		organization.SetupGated,
+		organizationinvite.SetupGated,
+		organizationmember.SetupGated,
+		pullsecretdistribution.SetupGated,
 		repository.SetupGated,
+		repositoryaccesspolicy.SetupGated,
 		repositorypermission.SetupGated,
 		robot.SetupGated,
+		robotaccount.SetupGated,
 		robotteammembership.SetupGated,
 		team.SetupGated,
 		token.SetupGated,
+		user.SetupGated,
+		userteammembership.SetupGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -17,8 +17,11 @@ limitations under the License.
 package team
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
@@ -30,9 +33,11 @@ import (
 
 	uperrors "github.com/upbound/up-sdk-go/errors"
 	"github.com/upbound/up-sdk-go/service/accounts"
+	"github.com/upbound/up-sdk-go/service/organizations"
 
 	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
 	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
 	"github.com/upbound/provider-upbound/internal/client/teams"
 	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
 )
@@ -49,6 +54,8 @@ const (
 type connector struct {
 	kube  client.Client
 	usage *resource.LegacyProviderConfigUsageTracker
+
+	requireAdoptionAnnotation bool
 }
 
 // Connect typically produces an ExternalClient by:
@@ -66,14 +73,19 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errTrackPCUsage)
 	}
 
-	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	cfg, profile, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
 	return &external{
-		teams:    teams.NewClient(cfg),
-		accounts: accounts.NewClient(cfg),
+		teams:                     teams.NewClient(cfg),
+		accounts:                  accounts.NewClient(cfg),
+		orgs:                      organizations.NewClient(cfg),
+		memberships:               robotteammembership.NewClient(cfg),
+		annotations:               managed.NewRetryingCriticalAnnotationUpdater(c.kube),
+		requireAdoptionAnnotation: c.requireAdoptionAnnotation,
+		organization:              profile.Account,
 	}, nil
 }
 
@@ -87,8 +99,18 @@ func (e *external) Disconnect(_ context.Context) error {
 type external struct {
 	// A 'client' used to connect to the external resource API. In practice this
 	// would be something like an AWS SDK client.
-	teams    *teams.Client
-	accounts *accounts.Client
+	teams       TeamClient
+	accounts    *accounts.Client
+	orgs        OrganizationClient
+	memberships MembershipClient
+
+	// organization is the organization of the ProviderConfig, which teams
+	// belong to unless they specify one.
+	organization string
+
+	// annotations persists the external name of an adopted team.
+	annotations               managed.CriticalAnnotationUpdater
+	requireAdoptionAnnotation bool
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -98,17 +120,38 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{}, nil
+		adopted, err := c.adopt(ctx, cr)
+		if err != nil || !adopted {
+			return managed.ExternalObservation{}, err
+		}
 	}
-	_, err := c.teams.Get(ctx, meta.GetExternalName(cr))
+	resp, err := c.teams.Get(ctx, meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "failed to get team")
 	}
+	li, err := c.lateInitialize(ctx, cr, resp)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	cr.Status.SetConditions(v1.Available())
+	cr.Status.AtProvider.OrganizationID = upclient.RelationshipID(resp.RelationshipSet, "organization")
+
+	// Name is not returned in the API, so only the robot members can be out of
+	// date.
+	upToDate := true
+	if cr.Spec.ForProvider.RobotMembers != nil {
+		members, err := c.robotMembers(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.RobotMembers = members
+		add, remove := robotMemberChanges(cr.Spec.ForProvider.RobotMembers, cr.Spec.ForProvider.Exclusive, members)
+		upToDate = len(add) == 0 && len(remove) == 0
+	}
 	return managed.ExternalObservation{
-		ResourceExists: true,
-		// Name is not returned in the API.
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: li,
 	}, nil
 }
 
@@ -117,23 +160,9 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotTeam)
 	}
-	orgIdInt := ptr.Deref(cr.Spec.ForProvider.OrganizationID, 0)
-	if orgIdInt < 0 {
-		return managed.ExternalCreation{}, errors.New(fmt.Sprintf("invalid OrganizationID: cannot convert negative int %d to uint", orgIdInt))
-	}
-	orgId := uint(orgIdInt)
-	if orgId == 0 {
-		if cr.Spec.ForProvider.OrganizationName == nil {
-			return managed.ExternalCreation{}, errors.New("either organizationName or organizationId must be specified")
-		}
-		o, err := c.accounts.Get(ctx, *cr.Spec.ForProvider.OrganizationName)
-		if err != nil {
-			return managed.ExternalCreation{}, errors.Wrapf(err, "failed to get account %s", *cr.Spec.ForProvider.OrganizationName)
-		}
-		if o.Account.Type != "organization" {
-			return managed.ExternalCreation{}, errors.Errorf("given account %s is not an organization", *cr.Spec.ForProvider.OrganizationName)
-		}
-		orgId = o.Organization.ID
+	orgId, err := c.organizationID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
 	}
 	resp, err := c.teams.Create(ctx, &teams.CreateParameters{
 		Name:           cr.Spec.ForProvider.Name,
@@ -147,10 +176,174 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalCreation{}, nil
 }
 
-func (c *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.Team)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTeam)
+	}
+	if cr.Spec.ForProvider.RobotMembers == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	// The robot members were observed right before the update.
+	add, remove := robotMemberChanges(cr.Spec.ForProvider.RobotMembers, cr.Spec.ForProvider.Exclusive, cr.Status.AtProvider.RobotMembers)
+	for _, id := range add {
+		err := c.memberships.Create(ctx, id, &robotteammembership.ResourceIdentifier{
+			ID:   meta.GetExternalName(cr),
+			Type: robotteammembership.RobotMembershipTypeTeam,
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, "failed to add robot %s to team", id)
+		}
+	}
+	for _, id := range remove {
+		err := c.memberships.Delete(ctx, id, &robotteammembership.DeleteParameters{
+			ID:   meta.GetExternalName(cr),
+			Type: robotteammembership.RobotMembershipTypeTeam,
+		})
+		if resource.Ignore(uperrors.IsNotFound, err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrapf(err, "failed to remove robot %s from team", id)
+		}
+	}
 	return managed.ExternalUpdate{}, nil
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
 	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.teams.Delete(ctx, meta.GetExternalName(mg))), "failed to delete team")
 }
+
+// lateInitialize sets the organization ID of the supplied Team if it is unset,
+// either from the organization the API reports the team to belong to or by
+// looking up its organization name. It returns true if it was set.
+func (c *external) lateInitialize(ctx context.Context, cr *iamv1alpha1cluster.Team, resp *teams.GetResponse) (bool, error) {
+	if cr.Spec.ForProvider.OrganizationID != nil {
+		return false, nil
+	}
+	if id := upclient.RelationshipID(resp.RelationshipSet, "organization"); id != "" {
+		o, err := strconv.Atoi(id)
+		if err != nil {
+			return false, errors.Wrap(err, "cannot parse organization id")
+		}
+		cr.Spec.ForProvider.OrganizationID = ptr.To(o)
+		return true, nil
+	}
+	if cr.Spec.ForProvider.OrganizationName == nil {
+		return false, nil
+	}
+	o, err := c.organizationID(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	cr.Spec.ForProvider.OrganizationID = ptr.To(int(o))
+	return true, nil
+}
+
+// organizationID returns the ID of the organization of the supplied Team,
+// which defaults to the organization of the ProviderConfig.
+func (c *external) organizationID(ctx context.Context, cr *iamv1alpha1cluster.Team) (uint, error) {
+	orgIdInt := ptr.Deref(cr.Spec.ForProvider.OrganizationID, 0)
+	if orgIdInt < 0 {
+		return 0, errors.New(fmt.Sprintf("invalid OrganizationID: cannot convert negative int %d to uint", orgIdInt))
+	}
+	if orgIdInt > 0 {
+		return uint(orgIdInt), nil
+	}
+	name := cmp.Or(ptr.Deref(cr.Spec.ForProvider.OrganizationName, ""), c.organization)
+	if name == "" {
+		return 0, errors.New("either organizationName or organizationId must be specified")
+	}
+	o, err := c.accounts.Get(ctx, name)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get account %s", name)
+	}
+	if o.Account.Type != "organization" {
+		return 0, errors.Errorf("given account %s is not an organization", name)
+	}
+	return o.Organization.ID, nil
+}
+
+// robotMembers returns the sorted IDs of the robots that are members of the
+// supplied Team.
+func (c *external) robotMembers(ctx context.Context, cr *iamv1alpha1cluster.Team) ([]string, error) {
+	orgID, err := c.organizationID(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	robots, err := c.orgs.ListRobots(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list robots")
+	}
+	var members []string
+	for _, rb := range robots {
+		for _, id := range rb.TeamIDs {
+			if id.String() == meta.GetExternalName(cr) {
+				members = append(members, rb.ID.String())
+				break
+			}
+		}
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+// robotMemberChanges returns the robots to add to and remove from a Team with
+// the supplied observed robot members. Robots are only removed from exclusive
+// Teams.
+func robotMemberChanges(desired []iamv1alpha1cluster.TeamRobotMember, exclusive bool, observed []string) (add, remove []string) {
+	want := make(map[string]bool, len(desired))
+	for _, m := range desired {
+		want[ptr.Deref(m.RobotID, "")] = true
+	}
+	have := make(map[string]bool, len(observed))
+	for _, id := range observed {
+		have[id] = true
+		if exclusive && !want[id] {
+			remove = append(remove, id)
+		}
+	}
+	for _, m := range desired {
+		if id := ptr.Deref(m.RobotID, ""); id != "" && !have[id] {
+			add = append(add, id)
+		}
+	}
+	return add, remove
+}
+
+// adopt looks for an existing team with the name of the supplied Team in its
+// organization before one is created. The team is adopted only when adoption
+// is allowed; otherwise the Team reports a conflict instead of creating a
+// duplicate.
+func (c *external) adopt(ctx context.Context, cr *iamv1alpha1cluster.Team) (bool, error) {
+	if meta.WasDeleted(cr) {
+		// Never adopt a resource only to delete it.
+		return false, nil
+	}
+	orgID, err := c.organizationID(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	list, err := c.teams.List(ctx, orgID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to list teams")
+	}
+	var found []teams.Team
+	for _, t := range list {
+		if t.Name == cr.Spec.ForProvider.Name {
+			found = append(found, t)
+		}
+	}
+	if len(found) == 0 {
+		return false, nil
+	}
+	if !upclient.AdoptionAllowed(cr, c.requireAdoptionAnnotation) {
+		msg := fmt.Sprintf("team %s already exists in organization %d, annotate with %s: \"true\" to adopt it", cr.Spec.ForProvider.Name, orgID, upclient.AnnotationKeyAdoptExisting)
+		cr.Status.SetConditions(upclient.Conflict(msg))
+		return false, errors.New(msg)
+	}
+	if len(found) > 1 {
+		msg := fmt.Sprintf("%d teams named %s exist in organization %d, set the external name to the ID of the one to adopt", len(found), cr.Spec.ForProvider.Name, orgID)
+		cr.Status.SetConditions(upclient.Conflict(msg))
+		return false, errors.New(msg)
+	}
+	meta.SetExternalName(cr, found[0].ID)
+	return true, errors.Wrap(c.annotations.UpdateCriticalAnnotations(ctx, cr), "failed to record adopted team")
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package team
+
+import (
+	"context"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+)
+
+type mockTeamClient struct {
+	getFn    func(ctx context.Context, id string) (*teams.GetResponse, error)
+	createFn func(ctx context.Context, params *teams.CreateParameters) (*teams.CreateResponse, error)
+	deleteFn func(ctx context.Context, id string) error
+	listFn   func(ctx context.Context, orgID uint) ([]teams.Team, error)
+}
+
+func (m *mockTeamClient) Get(ctx context.Context, id string) (*teams.GetResponse, error) {
+	return m.getFn(ctx, id)
+}
+
+func (m *mockTeamClient) Create(ctx context.Context, params *teams.CreateParameters) (*teams.CreateResponse, error) {
+	return m.createFn(ctx, params)
+}
+
+func (m *mockTeamClient) Delete(ctx context.Context, id string) error {
+	return m.deleteFn(ctx, id)
+}
+
+func (m *mockTeamClient) List(ctx context.Context, orgID uint) ([]teams.Team, error) {
+	return m.listFn(ctx, orgID)
+}
+
+type mockOrganizationClient struct {
+	listRobotsFn func(ctx context.Context, id uint) ([]organizations.Robot, error)
+}
+
+func (m *mockOrganizationClient) ListRobots(ctx context.Context, id uint) ([]organizations.Robot, error) {
+	return m.listRobotsFn(ctx, id)
+}
+
+type mockMembershipClient struct {
+	createFn func(ctx context.Context, robotID string, params *robotteammembership.ResourceIdentifier) error
+	deleteFn func(ctx context.Context, robotID string, params *robotteammembership.DeleteParameters) error
+}
+
+func (m *mockMembershipClient) Create(ctx context.Context, robotID string, params *robotteammembership.ResourceIdentifier) error {
+	return m.createFn(ctx, robotID, params)
+}
+
+func (m *mockMembershipClient) Delete(ctx context.Context, robotID string, params *robotteammembership.DeleteParameters) error {
+	return m.deleteFn(ctx, robotID, params)
+}
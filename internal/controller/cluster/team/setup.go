@@ -45,10 +45,19 @@ func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	name := managed.ControllerName(iamv1alpha1cluster.TeamGroupKind)
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnector(&connector{
-			kube:  mgr.GetClient(),
-			usage: resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1cluster.ProviderConfigUsage{}),
+			kube:                      mgr.GetClient(),
+			usage:                     resource.NewLegacyProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1cluster.ProviderConfigUsage{}),
+			requireAdoptionAnnotation: o.Features.Enabled(features.RequireAdoptionAnnotation),
 		}),
 		managed.WithPollInterval(o.PollInterval),
+		// Team IDs are assigned by the API, so the external name is not
+		// deterministic. The option is still set to bypass the
+		// ExternalCreateIncomplete protection, which would otherwise stop
+		// reconciling until the creation-pending annotation is removed by
+		// hand. It is safe since Observe looks teams up by name before one is
+		// created, and a Team whose creation is incomplete always adopts the
+		// team it finds instead of creating a duplicate.
+		managed.WithDeterministicExternalName(true),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithInitializers(),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -19,6 +19,7 @@ package token
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
@@ -26,6 +27,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -35,7 +37,9 @@ import (
 	"github.com/upbound/up-sdk-go/service/tokens"
 
 	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
 	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/token"
 	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
 )
 
@@ -74,9 +78,12 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	return &external{
-		tokens:   tokens.NewClient(cfg),
-		accounts: accounts.NewClient(cfg),
-		robots:   robots.NewClient(cfg),
+		tokens:      tokens.NewClient(cfg),
+		accounts:    accounts.NewClient(cfg),
+		robots:      robots.NewClient(cfg),
+		annotations: managed.NewRetryingCriticalAnnotationUpdater(c.kube),
+		secrets:     token.NewSecretPublisher(c.kube),
+		profiles:    token.NewProfiler(c.kube, config.GetProviderConfigSpecFn(cr)),
 	}, nil
 }
 
@@ -93,6 +100,19 @@ type external struct {
 	tokens   *tokens.Client
 	accounts *accounts.Client
 	robots   *robots.Client
+
+	// annotations persists the external name of a rotated token and the
+	// previous token, which would otherwise be lost since only status is
+	// written after Update.
+	annotations managed.CriticalAnnotationUpdater
+
+	// secrets writes the credentials to the output Secret, whose type depends
+	// on the output format.
+	secrets *token.SecretPublisher
+
+	// profiles logs in with the credentials when they are written as an up
+	// CLI profile.
+	profiles token.Profiler
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -112,10 +132,40 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "failed to get token")
 	}
+	// The first token's issue time is not recorded in status by Create, so we
+	// fall back to the time its creation was persisted.
+	issued := meta.GetExternalCreateSucceeded(cr)
+	if issued.IsZero() {
+		issued = time.Now()
+	}
+	token.UpdateObservation(&cr.Status.AtProvider.TokenObservation, cr.Spec.ForProvider.Rotation, resp, issued)
+	token.RestorePrevious(&cr.Status.AtProvider.TokenObservation, cr)
+
+	// The JWT is only returned on creation, so the credentials are read back
+	// from the Secrets they were written to in order to apply format changes.
+	var cd managed.ConnectionDetails
+	creds, ok, err := c.secrets.Lookup(ctx, uid.String(), credentialSources(cr)...)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot look up token credentials")
+	}
+	if ok {
+		if cd, err = token.ConnectionDetails(ctx, outputFormat(cr), creds, c.profiles); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, "cannot format token credentials")
+		}
+		if err := c.publishOutput(ctx, cr, creds); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, "cannot publish token output")
+		}
+	}
 	cr.Status.SetConditions(v1.Available())
+
+	now := time.Now()
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: resp.AttributeSet["name"] == cr.Spec.ForProvider.Name,
+		ConnectionDetails: cd,
+		ResourceExists:    true,
+		ResourceUpToDate: resp.AttributeSet["name"] == cr.Spec.ForProvider.Name &&
+			!token.RotationDue(cr.Status.AtProvider.TokenObservation, now) &&
+			!token.PreviousExpired(cr.Status.AtProvider.TokenObservation, now),
+		ResourceLateInitialized: upclient.LateInitialize(&cr.Spec.ForProvider.Owner.ID, upclient.RelationshipID(resp.RelationshipSet, "owner")),
 	}, nil
 }
 
@@ -124,7 +174,31 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotToken)
 	}
-	resp, err := c.tokens.Create(ctx, &tokens.TokenCreateParameters{
+	resp, err := c.issue(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create token")
+	}
+	meta.SetExternalName(cr, resp.ID.String())
+
+	creds := token.CredentialsFrom(resp)
+	cd, err := token.ConnectionDetails(ctx, outputFormat(cr), creds, c.profiles)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot format token credentials")
+	}
+	if err := c.publishOutput(ctx, cr, creds); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot publish token output")
+	}
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: cd,
+	}, nil
+}
+
+// issue creates a new token as described by the supplied Token.
+func (c *external) issue(ctx context.Context, cr *iamv1alpha1cluster.Token) (*tokens.TokenResponse, error) {
+	return c.tokens.Create(ctx, &tokens.TokenCreateParameters{
 		Attributes: tokens.TokenAttributes{
 			Name: cr.Spec.ForProvider.Name,
 		},
@@ -137,19 +211,6 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			},
 		},
 	})
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create token")
-	}
-	meta.SetExternalName(cr, resp.ID.String())
-
-	return managed.ExternalCreation{
-		// Optionally return any details that may be required to connect to the
-		// external resource. These will be stored as the connection secret.
-		ConnectionDetails: managed.ConnectionDetails{
-			"token": []byte(fmt.Sprint(resp.DataSet.Meta["jwt"])),
-			"id":    []byte(fmt.Sprint(resp.ID.String())),
-		},
-	}, nil
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -158,12 +219,67 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotToken)
 	}
 
-	_, err := c.tokens.Update(ctx, &tokens.TokenUpdateParameters{
+	uid, err := uuid.Parse(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot parse external name as UUID")
+	}
+	if _, err := c.tokens.Update(ctx, &tokens.TokenUpdateParameters{
+		ID: uid,
 		Attributes: tokens.TokenAttributes{
 			Name: cr.Spec.ForProvider.Name,
 		},
-	})
-	return managed.ExternalUpdate{}, errors.Wrap(err, "failed to update token")
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to update token")
+	}
+
+	now := time.Now()
+	obs := cr.Status.AtProvider.TokenObservation
+	// Only a single previous token is kept, so a rotation that is due before
+	// the overlap window of the last one has passed deletes it early.
+	if token.PreviousExpired(obs, now) || (token.RotationDue(obs, now) && obs.PreviousTokenID != "") {
+		if err := c.deleteToken(ctx, obs.PreviousTokenID); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "failed to delete previous token")
+		}
+		token.ClearPrevious(&obs)
+		if token.SetPreviousAnnotations(cr, obs) {
+			if err := c.annotations.UpdateCriticalAnnotations(ctx, cr); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, "failed to forget previous token")
+			}
+		}
+		cr.Status.AtProvider.TokenObservation = obs
+	}
+	if !token.RotationDue(obs, now) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	resp, err := c.issue(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to rotate token")
+	}
+	token.RecordRotation(&obs, cr.Spec.ForProvider.Rotation, resp, now)
+	meta.SetExternalName(cr, resp.ID.String())
+	token.SetPreviousAnnotations(cr, obs)
+	if err := c.annotations.UpdateCriticalAnnotations(ctx, cr); err != nil {
+		// Do not leak a token we could not record.
+		_ = c.deleteToken(ctx, resp.ID.String())
+		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to record rotated token")
+	}
+	// Persisting the annotations resets the status to what is stored in the
+	// API server, so the rotation is recorded in it only afterwards.
+	cr.Status.AtProvider.TokenObservation = obs
+
+	creds := token.CredentialsFrom(resp)
+	cd, err := token.ConnectionDetails(ctx, outputFormat(cr), creds, c.profiles)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot format token credentials")
+	}
+	if err := c.publishOutput(ctx, cr, creds); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot publish token output")
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: cd,
+	}, nil
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
@@ -171,9 +287,52 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotToken)
 	}
-	uid, err := uuid.Parse(meta.GetExternalName(cr))
+	if id := cr.Status.AtProvider.PreviousTokenID; id != "" {
+		if err := c.deleteToken(ctx, id); err != nil {
+			return managed.ExternalDelete{}, errors.Wrap(err, "failed to delete previous token")
+		}
+	}
+	return managed.ExternalDelete{}, errors.Wrap(c.deleteToken(ctx, meta.GetExternalName(cr)), "failed to delete token")
+}
+
+// deleteToken deletes the token with the supplied ID if it still exists.
+func (c *external) deleteToken(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
 	if err != nil {
-		return managed.ExternalDelete{}, errors.Wrap(err, "cannot parse external name as UUID")
+		return errors.Wrap(err, "cannot parse token ID as UUID")
+	}
+	return resource.Ignore(uperrors.IsNotFound, c.tokens.Delete(ctx, uid))
+}
+
+// publishOutput writes the supplied credentials to the output Secret of the
+// supplied Token, if one is configured.
+func (c *external) publishOutput(ctx context.Context, cr *iamv1alpha1cluster.Token, creds token.Credentials) error {
+	o := cr.Spec.ForProvider.Output
+	if o == nil || o.SecretRef == nil {
+		return nil
+	}
+	ref := types.NamespacedName{Name: o.SecretRef.Name, Namespace: o.SecretRef.Namespace}
+	return c.secrets.Publish(ctx, cr, iamv1alpha1cluster.TokenGroupVersionKind, ref, o.TokenFormat, creds, c.profiles)
+}
+
+// outputFormat returns the format the credentials of the supplied Token are
+// written in.
+func outputFormat(cr *iamv1alpha1cluster.Token) iamv1alpha1common.TokenFormat {
+	if cr.Spec.ForProvider.Output == nil {
+		return iamv1alpha1common.TokenFormat{}
+	}
+	return cr.Spec.ForProvider.Output.TokenFormat
+}
+
+// credentialSources returns the Secrets the credentials of the supplied Token
+// are written to.
+func credentialSources(cr *iamv1alpha1cluster.Token) []types.NamespacedName {
+	var refs []types.NamespacedName
+	if ref := cr.GetWriteConnectionSecretToReference(); ref != nil {
+		refs = append(refs, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace})
+	}
+	if o := cr.Spec.ForProvider.Output; o != nil && o.SecretRef != nil {
+		refs = append(refs, types.NamespacedName{Name: o.SecretRef.Name, Namespace: o.SecretRef.Namespace})
 	}
-	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, c.tokens.Delete(ctx, uid)), "failed to delete token")
+	return refs
 }
@@ -0,0 +1,185 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/accounts"
+	"github.com/upbound/up-sdk-go/service/organizations"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+)
+
+const (
+	errNotUser         = "managed resource is not a User custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errNewClient       = "cannot create new client"
+	errNoUser          = "either username or email is required"
+	errNoOrganization  = "organizationName is required to look up a user by email"
+	errReadOnly        = "users cannot be created or updated"
+	errNotUserAccount  = "account %s is not a user"
+	errGetAccount      = "cannot get account"
+	errListOrgMembers  = "cannot list organization members"
+	errGetOrganization = "cannot get organization"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage *resource.LegacyProviderConfigUsageTracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.User)
+	if !ok {
+		return nil, errors.New(errNotUser)
+	}
+
+	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		accounts:      accounts.NewClient(cfg),
+		organizations: organizations.NewClient(cfg),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	accounts      *accounts.Client
+	organizations *organizations.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+
+	username := ptr.Deref(cr.Spec.ForProvider.Username, "")
+	if username == "" {
+		m, err := c.member(ctx, cr)
+		if err != nil || m == nil {
+			return managed.ExternalObservation{}, err
+		}
+		username = m.User.Username
+	}
+
+	acc, err := c.accounts.Get(ctx, username)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), errGetAccount)
+	}
+	if acc.Account.Type != accounts.AccountUser || acc.User == nil {
+		return managed.ExternalObservation{}, errors.Errorf(errNotUserAccount, username)
+	}
+
+	u := acc.User
+	cr.Status.AtProvider = iamv1alpha1cluster.UserObservation{
+		ID:        u.ID,
+		Username:  u.Username,
+		Email:     u.Email,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+	}
+	if cr.Status.AtProvider.Email == "" {
+		// The email of a user is only visible to themselves.
+		cr.Status.AtProvider.Email = ptr.Deref(cr.Spec.ForProvider.Email, "")
+	}
+	if u.CreatedAt != nil {
+		cr.Status.AtProvider.CreatedAt = &metav1.Time{Time: *u.CreatedAt}
+	}
+	cr.Status.SetConditions(xpv1.Available())
+
+	li := upclient.LateInitialize(&cr.Spec.ForProvider.Username, u.Username)
+	li = upclient.LateInitialize(&cr.Spec.ForProvider.Email, u.Email) || li
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+// member returns the member of the organization of the supplied User with the
+// User's email, or nil if there is none.
+func (c *external) member(ctx context.Context, cr *iamv1alpha1cluster.User) (*organizations.Member, error) {
+	email := ptr.Deref(cr.Spec.ForProvider.Email, "")
+	if email == "" {
+		return nil, errors.New(errNoUser)
+	}
+	if ptr.Deref(cr.Spec.ForProvider.OrganizationName, "") == "" {
+		return nil, errors.New(errNoOrganization)
+	}
+	orgID, err := c.organizations.GetOrgID(ctx, *cr.Spec.ForProvider.OrganizationName)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetOrganization)
+	}
+	members, err := c.organizations.ListMembers(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, errListOrgMembers)
+	}
+	for i := range members {
+		if strings.EqualFold(members[i].User.Email, email) {
+			return &members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *external) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, errors.New(errReadOnly)
+}
+
+func (c *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, errors.New(errReadOnly)
+}
+
+func (c *external) Delete(_ context.Context, _ resource.Managed) (managed.ExternalDelete, error) {
+	// Deleting a User only stops observing the user.
+	return managed.ExternalDelete{}, nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userteammembership
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/userteammembership"
+	"github.com/upbound/provider-upbound/internal/controller/cluster/config"
+)
+
+const (
+	errNotUserTeamMembership = "managed resource is not a UserTeamMembership custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errNewClient             = "cannot create new Service"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube  client.Client
+	usage *resource.LegacyProviderConfigUsageTracker
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.UserTeamMembership)
+	if !ok {
+		return nil, errors.New(errNotUserTeamMembership)
+	}
+
+	if err := c.usage.Track(ctx, mg.(resource.LegacyManaged)); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		userTeamMemberships: userteammembership.NewClient(cfg),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	userTeamMemberships *userteammembership.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.UserTeamMembership)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUserTeamMembership)
+	}
+
+	// External name annotation is not used since the membership is identified
+	// by the team and the user, which are both under spec.
+
+	m, err := c.userTeamMemberships.Get(ctx, ptr.Deref(cr.Spec.ForProvider.TeamID, ""), user(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get user team membership")
+	}
+	cr.Status.AtProvider.UserID = int(m.User.ID)
+	cr.Status.AtProvider.Role = m.Role
+	cr.Status.SetConditions(v1.Available())
+
+	li := upclient.LateInitialize(&cr.Spec.ForProvider.Username, m.User.Username)
+	li = upclient.LateInitialize(&cr.Spec.ForProvider.Email, m.User.Email) || li
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        m.Role == role(cr),
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.UserTeamMembership)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUserTeamMembership)
+	}
+	u := user(cr)
+	if u.Username == "" && u.Email == "" {
+		return managed.ExternalCreation{}, errors.New("either username or email must be specified")
+	}
+	if err := c.userTeamMemberships.Create(ctx, ptr.Deref(cr.Spec.ForProvider.TeamID, ""), &userteammembership.CreateParameters{
+		Username: u.Username,
+		Email:    u.Email,
+		Role:     role(cr),
+	}); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create team membership for the user")
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.UserTeamMembership)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotUserTeamMembership)
+	}
+	err := c.userTeamMemberships.Update(ctx, ptr.Deref(cr.Spec.ForProvider.TeamID, ""), uint(cr.Status.AtProvider.UserID), &userteammembership.UpdateParameters{
+		Role: role(cr),
+	})
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update role of the user in the team")
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*iamv1alpha1cluster.UserTeamMembership)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotUserTeamMembership)
+	}
+	err := c.userTeamMemberships.Delete(ctx, ptr.Deref(cr.Spec.ForProvider.TeamID, ""), uint(cr.Status.AtProvider.UserID))
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot delete user team membership")
+}
+
+// user returns the user identified by the supplied UserTeamMembership.
+func user(cr *iamv1alpha1cluster.UserTeamMembership) userteammembership.User {
+	if u := ptr.Deref(cr.Spec.ForProvider.Username, ""); u != "" {
+		return userteammembership.User{Username: u}
+	}
+	return userteammembership.User{Email: ptr.Deref(cr.Spec.ForProvider.Email, "")}
+}
+
+// role returns the desired role of the user in the team.
+func role(cr *iamv1alpha1cluster.UserTeamMembership) string {
+	return ptr.Deref(cr.Spec.ForProvider.Role, userteammembership.RoleMember)
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"path"
+	"slices"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8scli "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
+	repov1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
+	"github.com/upbound/provider-upbound/apis/namespaced/v1alpha1"
+)
+
+// ReasonForbidden is the reason of the Ready condition of a managed resource
+// that may not use its ClusterProviderConfig.
+const ReasonForbidden xpv1.ConditionReason = "Forbidden"
+
+// namespaces reads the namespaces ClusterProviderConfigs select by label. It
+// bypasses the cache of the manager, so that evaluating a namespace selector
+// only requires the provider to get namespaces rather than to watch them. It
+// is set up with the ClusterProviderConfig controller.
+var namespaces k8scli.Reader
+
+// Forbidden returns a condition indicating that a managed resource may not
+// use its ClusterProviderConfig.
+func Forbidden(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonForbidden,
+		Message:            msg,
+	}
+}
+
+// checkAllowed returns an error if the allow list of the supplied
+// ClusterProviderConfig does not allow the supplied managed resource to use
+// it, and sets the Forbidden condition of the managed resource.
+func checkAllowed(ctx context.Context, kube k8scli.Client, mg resource.ModernManaged, pc *v1alpha1.ClusterProviderConfig) error {
+	ns := namespaces
+	if ns == nil {
+		ns = kube
+	}
+	reason, err := forbidden(ctx, kube, ns, mg, &pc.Spec)
+	if err != nil {
+		return errors.Wrapf(err, "cannot check whether managed resource %s/%s may use ClusterProviderConfig %s", mg.GetNamespace(), mg.GetName(), pc.GetName())
+	}
+	if reason == "" {
+		return nil
+	}
+	msg := "managed resource may not use ClusterProviderConfig " + pc.GetName() + ": " + reason
+	mg.SetConditions(Forbidden(msg))
+	return errors.New(msg)
+}
+
+// forbidden returns why the supplied managed resource may not use a
+// ClusterProviderConfig with the supplied spec, or an empty string if it may.
+// Its namespace is read with the supplied reader.
+func forbidden(ctx context.Context, kube k8scli.Client, ns k8scli.Reader, mg resource.ModernManaged, spec *v1alpha1.ClusterProviderConfigSpec) (string, error) {
+	a := spec.Allow
+	if a == nil {
+		return "", nil
+	}
+
+	if a.NamespaceSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(a.NamespaceSelector)
+		if err != nil {
+			return "", errors.Wrap(err, "cannot parse namespace selector")
+		}
+		n := &corev1.Namespace{}
+		err = ns.Get(ctx, types.NamespacedName{Name: mg.GetNamespace()}, n)
+		if kerrors.IsForbidden(err) {
+			// Fail closed, and tell why on the managed resource.
+			return "namespace " + mg.GetNamespace() + " cannot be checked against the namespace selector: " + err.Error(), nil
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "cannot get namespace %s", mg.GetNamespace())
+		}
+		if !sel.Matches(labels.Set(n.GetLabels())) {
+			return "namespace " + mg.GetNamespace() + " is not selected", nil
+		}
+	}
+
+	if len(a.Organizations) == 0 && len(a.Repositories) == 0 {
+		return "", nil
+	}
+	gvk, err := apiutil.GVKForObject(mg, kube.Scheme())
+	if err != nil {
+		return "", errors.Wrap(err, "cannot get kind of managed resource")
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot convert managed resource to unstructured")
+	}
+	p := fieldpath.Pave(u)
+
+	if len(a.Organizations) > 0 {
+		org, reason := organization(p, gvk.GroupKind(), spec.Organization)
+		if reason != "" {
+			return reason, nil
+		}
+		if !slices.Contains(a.Organizations, org) {
+			return "organization " + org + " is not allowed", nil
+		}
+	}
+
+	if len(a.Repositories) > 0 {
+		repo := repository(p, gvk.GroupKind())
+		if repo == "" {
+			return "", nil
+		}
+		for _, pattern := range a.Repositories {
+			ok, err := path.Match(pattern, repo)
+			if err != nil {
+				return "", errors.Wrapf(err, "cannot match repository pattern %q", pattern)
+			}
+			if ok {
+				return "", nil
+			}
+		}
+		return "repository " + repo + " is not allowed", nil
+	}
+	return "", nil
+}
+
+// unscoped are the kinds that do not specify the organization they act on,
+// e.g. because they identify what they act on by ID. The organization of the
+// provider config is not theirs, so they cannot be checked against the
+// allowed organizations.
+var unscoped = []schema.GroupKind{
+	iamv1beta1.SchemeGroupVersion.WithKind(iamv1beta1.TokenKind).GroupKind(),
+	iamv1beta1.SchemeGroupVersion.WithKind(iamv1beta1.RobotTeamMembershipKind).GroupKind(),
+	iamv1beta1.SchemeGroupVersion.WithKind(iamv1beta1.UserTeamMembershipKind).GroupKind(),
+}
+
+// organization returns the name of the organization the supplied managed
+// resource acts on, which defaults to the organization of its provider
+// config. It returns why instead if the organization cannot be checked
+// against the allowed organizations.
+func organization(p *fieldpath.Paved, gk schema.GroupKind, def string) (string, string) {
+	if gk == iamv1beta1.SchemeGroupVersion.WithKind(iamv1beta1.OrganizationKind).GroupKind() {
+		name, _ := p.GetString("spec.forProvider.name")
+		return name, ""
+	}
+	if slices.Contains(unscoped, gk) {
+		return "", "the organization of a " + gk.Kind + " cannot be checked against the allowed organizations"
+	}
+	if name, _ := p.GetString("spec.forProvider.organizationName"); name != "" {
+		return name, ""
+	}
+	if id, _ := p.GetString("spec.forProvider.organizationId"); id != "" {
+		return "", "organizationId cannot be checked against the allowed organizations, specify organizationName instead"
+	}
+	return def, ""
+}
+
+// repository returns the name of the repository the supplied managed resource
+// acts on, or an empty string if it acts on none. Repositories are named by
+// the name of a Repository and the repository of other kinds. The repo of a
+// Configuration is a Git repository, not a repository on Upbound.
+func repository(p *fieldpath.Paved, gk schema.GroupKind) string {
+	if gk == repov1beta1.SchemeGroupVersion.WithKind(repov1beta1.RepositoryKind).GroupKind() {
+		name, _ := p.GetString("spec.forProvider.name")
+		return name
+	}
+	name, _ := p.GetString("spec.forProvider.repository")
+	return name
+}
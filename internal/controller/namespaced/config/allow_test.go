@@ -0,0 +1,313 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pcv1alpha1common "github.com/upbound/provider-upbound/apis/common/providerconfig/v1alpha1"
+	apis "github.com/upbound/provider-upbound/apis/namespaced"
+	cpv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/controlplane/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
+	repov1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
+	"github.com/upbound/provider-upbound/apis/namespaced/v1alpha1"
+)
+
+func TestForbidden(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"tenant": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"tenant": "b"}}},
+	).Build()
+
+	team := func(ns string, name, id *string) *iamv1beta1.Team {
+		return &iamv1beta1.Team{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "team"},
+			Spec: iamv1beta1.TeamSpec{ForProvider: iamv1beta1.TeamParameters{
+				Name:             "team",
+				OrganizationName: name,
+				OrganizationID:   id,
+			}},
+		}
+	}
+	spec := func(a *v1alpha1.AllowList) *v1alpha1.ClusterProviderConfigSpec {
+		return &v1alpha1.ClusterProviderConfigSpec{
+			ProviderConfigSpec: pcv1alpha1common.ProviderConfigSpec{Organization: "default"},
+			Allow:              a,
+		}
+	}
+
+	errForbidden := kerrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "team-a", errors.New("boom"))
+
+	cases := map[string]struct {
+		mg   resource.ModernManaged
+		ns   client.Reader
+		spec *v1alpha1.ClusterProviderConfigSpec
+		want string
+	}{
+		"NoAllowList": {
+			mg:   team("team-b", ptr.To("other"), nil),
+			spec: spec(nil),
+		},
+		"NamespaceSelected": {
+			mg:   team("team-a", nil, nil),
+			spec: spec(&v1alpha1.AllowList{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}}),
+		},
+		"NamespaceNotSelected": {
+			mg:   team("team-b", nil, nil),
+			spec: spec(&v1alpha1.AllowList{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}}),
+			want: "namespace team-b is not selected",
+		},
+		"NamespaceForbidden": {
+			mg:   team("team-a", nil, nil),
+			ns:   &test.MockClient{MockGet: test.NewMockGetFn(errForbidden)},
+			spec: spec(&v1alpha1.AllowList{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}}),
+			want: "namespace team-a cannot be checked against the namespace selector: " + errForbidden.Error(),
+		},
+		"OrganizationAllowed": {
+			mg:   team("team-a", ptr.To("team-a"), nil),
+			spec: spec(&v1alpha1.AllowList{Organizations: []string{"team-a"}}),
+		},
+		"OrganizationNotAllowed": {
+			mg:   team("team-a", ptr.To("team-b"), nil),
+			spec: spec(&v1alpha1.AllowList{Organizations: []string{"team-a"}}),
+			want: "organization team-b is not allowed",
+		},
+		"DefaultOrganizationNotAllowed": {
+			mg:   team("team-a", nil, nil),
+			spec: spec(&v1alpha1.AllowList{Organizations: []string{"team-a"}}),
+			want: "organization default is not allowed",
+		},
+		"OrganizationOnlyByID": {
+			mg:   team("team-a", nil, ptr.To("42")),
+			spec: spec(&v1alpha1.AllowList{Organizations: []string{"team-a"}}),
+			want: "organizationId cannot be checked against the allowed organizations, specify organizationName instead",
+		},
+		"OrganizationUnspecified": {
+			mg: &iamv1beta1.RobotTeamMembership{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "membership"},
+			},
+			spec: spec(&v1alpha1.AllowList{Organizations: []string{"default"}}),
+			want: "the organization of a RobotTeamMembership cannot be checked against the allowed organizations",
+		},
+		"OrganizationKindNotAllowed": {
+			mg: &iamv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "org"},
+				Spec:       iamv1beta1.OrganizationSpec{ForProvider: iamv1beta1.OrganizationParameters{Name: "team-b"}},
+			},
+			spec: spec(&v1alpha1.AllowList{Organizations: []string{"team-a"}}),
+			want: "organization team-b is not allowed",
+		},
+		"RepositoryAllowed": {
+			mg: &repov1beta1.Repository{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "repo"},
+				Spec:       repov1beta1.RepositorySpec{ForProvider: repov1beta1.RepositoryParameters{Name: "team-a-config"}},
+			},
+			spec: spec(&v1alpha1.AllowList{Repositories: []string{"team-a-*"}}),
+		},
+		"PermissionRepositoryNotAllowed": {
+			mg: &repov1beta1.Permission{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "perm"},
+				Spec:       repov1beta1.PermissionSpec{ForProvider: repov1beta1.PermissionParameters{Repository: ptr.To("team-b-config")}},
+			},
+			spec: spec(&v1alpha1.AllowList{Repositories: []string{"team-a-*"}}),
+			want: "repository team-b-config is not allowed",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ns := tc.ns
+			if ns == nil {
+				ns = kube
+			}
+			got, err := forbidden(context.Background(), kube, ns, tc.mg, tc.spec)
+			if err != nil {
+				t.Fatalf("forbidden(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("forbidden(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestForbiddenKinds checks that the organization and repository of every
+// namespaced kind are read from the parameters the kind names them with. The
+// allow list only allows organization team-a, so an organization that is not
+// read is rejected as the default organization, and repositories starting
+// with team-a, so any repository that is read is rejected.
+func TestForbiddenKinds(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(s).Build()
+	spec := &v1alpha1.ClusterProviderConfigSpec{
+		ProviderConfigSpec: pcv1alpha1common.ProviderConfigSpec{Organization: "default"},
+		Allow: &v1alpha1.AllowList{
+			Organizations: []string{"team-a"},
+			Repositories:  []string{"team-a-*"},
+		},
+	}
+	om := metav1.ObjectMeta{Namespace: "team-a", Name: "mr"}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.ModernManaged
+		want   string
+	}{
+		"Configuration": {
+			reason: "The repo of a Configuration is a Git repository, not a repository on Upbound.",
+			mg: &cpv1alpha1.Configuration{ObjectMeta: om, Spec: cpv1alpha1.ConfigurationSpec{ForProvider: cpv1alpha1.ConfigurationParameters{
+				OrganizationName: "team-a",
+				Repo:             "team-b-config",
+			}}},
+		},
+		"ControlPlane": {
+			mg: &cpv1alpha1.ControlPlane{ObjectMeta: om, Spec: cpv1alpha1.ControlPlaneSpec{ForProvider: cpv1alpha1.ControlPlaneParameters{
+				OrganizationName: "team-a",
+			}}},
+		},
+		"ControlPlanePermission": {
+			mg: &iamv1beta1.ControlPlanePermission{ObjectMeta: om, Spec: iamv1beta1.ControlPlanePermissionSpec{ForProvider: iamv1beta1.ControlPlanePermissionParameters{
+				OrganizationName: "team-a",
+			}}},
+		},
+		"Organization": {
+			mg: &iamv1beta1.Organization{ObjectMeta: om, Spec: iamv1beta1.OrganizationSpec{ForProvider: iamv1beta1.OrganizationParameters{
+				Name: "team-a",
+			}}},
+		},
+		"OrganizationInvite": {
+			mg: &iamv1beta1.OrganizationInvite{ObjectMeta: om, Spec: iamv1beta1.OrganizationInviteSpec{ForProvider: iamv1beta1.OrganizationInviteParameters{
+				OrganizationName: "team-a",
+			}}},
+		},
+		"OrganizationMember": {
+			mg: &iamv1beta1.OrganizationMember{ObjectMeta: om, Spec: iamv1beta1.OrganizationMemberSpec{ForProvider: iamv1beta1.OrganizationMemberParameters{
+				OrganizationName: "team-a",
+			}}},
+		},
+		"Robot": {
+			mg: &iamv1beta1.Robot{ObjectMeta: om, Spec: iamv1beta1.RobotSpec{ForProvider: iamv1beta1.RobotParameters{
+				OrganizationName: ptr.To("team-a"),
+			}}},
+		},
+		"RobotAccount": {
+			mg: &iamv1beta1.RobotAccount{ObjectMeta: om, Spec: iamv1beta1.RobotAccountSpec{ForProvider: iamv1beta1.RobotAccountParameters{
+				OrganizationName: ptr.To("team-a"),
+			}}},
+		},
+		"RobotTeamMembership": {
+			mg:   &iamv1beta1.RobotTeamMembership{ObjectMeta: om},
+			want: "the organization of a RobotTeamMembership cannot be checked against the allowed organizations",
+		},
+		"Team": {
+			mg: &iamv1beta1.Team{ObjectMeta: om, Spec: iamv1beta1.TeamSpec{ForProvider: iamv1beta1.TeamParameters{
+				OrganizationName: ptr.To("team-a"),
+			}}},
+		},
+		"Token": {
+			mg:   &iamv1beta1.Token{ObjectMeta: om},
+			want: "the organization of a Token cannot be checked against the allowed organizations",
+		},
+		"User": {
+			mg: &iamv1beta1.User{ObjectMeta: om, Spec: iamv1beta1.UserSpec{ForProvider: iamv1beta1.UserParameters{
+				OrganizationName: ptr.To("team-a"),
+			}}},
+		},
+		"UserTeamMembership": {
+			mg:   &iamv1beta1.UserTeamMembership{ObjectMeta: om},
+			want: "the organization of a UserTeamMembership cannot be checked against the allowed organizations",
+		},
+		"Permission": {
+			mg: &repov1beta1.Permission{ObjectMeta: om, Spec: repov1beta1.PermissionSpec{ForProvider: repov1beta1.PermissionParameters{
+				OrganizationName: "team-a",
+				Repository:       ptr.To("team-b-config"),
+			}}},
+			want: "repository team-b-config is not allowed",
+		},
+		"Repository": {
+			mg: &repov1beta1.Repository{ObjectMeta: om, Spec: repov1beta1.RepositorySpec{ForProvider: repov1beta1.RepositoryParameters{
+				OrganizationName: "team-a",
+				Name:             "team-b-config",
+			}}},
+			want: "repository team-b-config is not allowed",
+		},
+		"RepositoryAccessPolicy": {
+			mg: &repov1beta1.RepositoryAccessPolicy{ObjectMeta: om, Spec: repov1beta1.RepositoryAccessPolicySpec{ForProvider: repov1beta1.RepositoryAccessPolicyParameters{
+				OrganizationName: "team-a",
+				Repository:       ptr.To("team-b-config"),
+			}}},
+			want: "repository team-b-config is not allowed",
+		},
+	}
+
+	// Every namespaced managed resource kind has to be covered.
+	for gvk := range s.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, "m.upbound.io") {
+			continue
+		}
+		obj, err := s.New(gvk)
+		if err != nil {
+			continue
+		}
+		if _, ok := obj.(resource.ModernManaged); !ok {
+			continue
+		}
+		if _, ok := cases[gvk.Kind]; !ok {
+			t.Errorf("TestForbiddenKinds: %s is not covered", gvk.GroupKind())
+		}
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := forbidden(context.Background(), kube, kube, tc.mg, spec)
+			if err != nil {
+				t.Fatalf("\n%s\nforbidden(...): %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nforbidden(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -65,6 +65,11 @@ func GetProviderConfigSpecFn(mg resource.ModernManaged) client.GetProviderConfig
 			return &pc.Spec.ProviderConfigSpec, nil
 
 		case *v1alpha1.ClusterProviderConfig:
+			// ClusterProviderConfigs can be used from any namespace, so they
+			// may restrict what their credentials are used for.
+			if err := checkAllowed(ctx, kube, mg, pc); err != nil {
+				return nil, err
+			}
 			return &pc.Spec.ProviderConfigSpec, nil
 
 		default:
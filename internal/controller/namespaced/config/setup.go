@@ -65,6 +65,7 @@ func setupNamespaced(mgr ctrl.Manager, o controller.Options) error {
 // SetupClusterScopedGated calls setupClusterScoped when the
 // ClusterProviderConfig GVR becomes available in the API.
 func SetupClusterScopedGated(mgr ctrl.Manager, o controller.Options) error {
+	namespaces = mgr.GetAPIReader()
 	o.Gate.Register(func() {
 		if err := setupClusterScoped(mgr, o); err != nil {
 			panic(err)
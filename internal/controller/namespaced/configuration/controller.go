@@ -0,0 +1,170 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configuration
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/configurations"
+
+	controlplanev1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/controlplane/v1alpha1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/configuration"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/config"
+)
+
+const (
+	errNotConfiguration = "managed resource is not a Configuration custom resource"
+	errNewClient        = "cannot create new Service"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*controlplanev1alpha1.Configuration)
+	if !ok {
+		return nil, errors.New(errNotConfiguration)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		configurations: configuration.NewClient(cfg),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	configurations *configuration.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*controlplanev1alpha1.Configuration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotConfiguration)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := e.configurations.Get(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get configuration")
+	}
+	cr.Status.AtProvider.ConfigurationObservation = configuration.StatusFromResponse(resp.ConfigurationResponse)
+	li := lateInitialize(&cr.Spec.ForProvider, resp)
+
+	// A configuration cannot be installed in a control plane until a version
+	// of it has been built from its repository.
+	cr.Status.SetConditions(xpv1.Creating())
+	if resp.LatestVersion != nil {
+		cr.Status.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// Only the description of a configuration can be updated.
+		ResourceUpToDate:        cr.Spec.ForProvider.Description == nil || *cr.Spec.ForProvider.Description == ptr.Deref(resp.Description, ""),
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*controlplanev1alpha1.Configuration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotConfiguration)
+	}
+	p := cr.Spec.ForProvider
+	if p.TemplateID == "" || p.Context == "" || p.Repo == "" {
+		return managed.ExternalCreation{}, errors.New("templateId, context and repo are required to create a configuration")
+	}
+	_, err := e.configurations.Create(ctx, p.OrganizationName, &configuration.CreateParameters{
+		ConfigurationCreateParameters: configurations.ConfigurationCreateParameters{
+			Name:       p.Name,
+			TemplateID: p.TemplateID,
+			Provider:   configurations.Provider(p.Provider),
+			Context:    p.Context,
+			Repo:       p.Repo,
+			Private:    p.Private,
+		},
+		Description: p.Description,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create configuration")
+	}
+	meta.SetExternalName(cr, p.Name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*controlplanev1alpha1.Configuration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotConfiguration)
+	}
+	// Only the description of a configuration can be updated.
+	err := e.configurations.Update(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr), &configuration.UpdateParameters{
+		Description: cr.Spec.ForProvider.Description,
+	})
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update configuration")
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*controlplanev1alpha1.Configuration)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotConfiguration)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, e.configurations.Delete(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr))), "cannot delete configuration")
+}
+
+// lateInitialize sets the unset fields of the supplied parameters from the
+// supplied configuration. It returns true if any of them was set.
+func lateInitialize(p *controlplanev1alpha1.ConfigurationParameters, resp *configuration.Response) bool {
+	li := upclient.LateInitialize(&p.Description, ptr.Deref(resp.Description, ""))
+	li = upclient.LateInitializeString(&p.TemplateID, resp.TemplateID) || li
+	li = upclient.LateInitializeString(&p.Provider, string(resp.Provider)) || li
+	li = upclient.LateInitializeString(&p.Context, resp.Context) || li
+	return upclient.LateInitializeString(&p.Repo, resp.Repo) || li
+}
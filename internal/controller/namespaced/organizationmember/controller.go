@@ -0,0 +1,148 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationmember
+
+import (
+	"context"
+
+	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/organizations"
+
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/organizationmember"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/config"
+)
+
+const (
+	errNotOrganizationMember = "managed resource is not an OrganizationMember custom resource"
+	errNewClient             = "cannot create new Service"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube client.Client
+}
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*iamv1beta1.OrganizationMember)
+	if !ok {
+		return nil, errors.New(errNotOrganizationMember)
+	}
+
+	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{
+		members:       organizationmember.NewClient(cfg),
+		organizations: organizations.NewClient(cfg),
+	}, nil
+}
+
+func (e *external) Disconnect(_ context.Context) error {
+	// If there's nothing special to clean up, just return nil.
+	return nil
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	members       *organizationmember.Client
+	organizations *organizations.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*iamv1beta1.OrganizationMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganizationMember)
+	}
+
+	// External name annotation is not used since a member is identified by
+	// the organization and the username, which are both under spec.
+
+	orgID, err := e.organizations.GetOrgID(ctx, cr.Spec.ForProvider.OrganizationName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot get organization id")
+	}
+	m, err := e.members.Get(ctx, orgID, cr.Spec.ForProvider.Username)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get organization member")
+	}
+	cr.Status.AtProvider.UserID = int(m.User.ID)
+	cr.Status.AtProvider.Email = m.User.Email
+	cr.Status.AtProvider.Role = string(m.Permission)
+	cr.Status.SetConditions(v1.Available())
+
+	li := upclient.LateInitializeString(&cr.Spec.ForProvider.Role, string(m.Permission))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        string(m.Permission) == cr.Spec.ForProvider.Role,
+		ResourceLateInitialized: li,
+	}, nil
+}
+
+func (e *external) Create(_ context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*iamv1beta1.OrganizationMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganizationMember)
+	}
+	// Users can only join an organization by accepting an invite.
+	return managed.ExternalCreation{}, errors.Errorf("user %s is not a member of organization %s, invite them with an OrganizationInvite first", cr.Spec.ForProvider.Username, cr.Spec.ForProvider.OrganizationName)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*iamv1beta1.OrganizationMember)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganizationMember)
+	}
+	orgID, err := e.organizations.GetOrgID(ctx, cr.Spec.ForProvider.OrganizationName)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot get organization id")
+	}
+	err = e.members.Update(ctx, orgID, uint(cr.Status.AtProvider.UserID), &organizationmember.UpdateParameters{
+		Permission: organizations.OrganizationPermissionGroup(cr.Spec.ForProvider.Role),
+	})
+	return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update role of organization member")
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*iamv1beta1.OrganizationMember)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotOrganizationMember)
+	}
+	orgID, err := e.organizations.GetOrgID(ctx, cr.Spec.ForProvider.OrganizationName)
+	if err != nil {
+		return managed.ExternalDelete{}, errors.Wrap(err, "cannot get organization id")
+	}
+	err = e.members.Delete(ctx, orgID, uint(cr.Status.AtProvider.UserID))
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot remove organization member")
+}
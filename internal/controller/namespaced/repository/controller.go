@@ -17,6 +17,7 @@ limitations under the License.
 package repository
 
 import (
+	"cmp"
 	"context"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -30,7 +31,7 @@ import (
 	uperrors "github.com/upbound/up-sdk-go/errors"
 	"github.com/upbound/up-sdk-go/service/repositories"
 
-	repov1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1alpha1"
+	repov1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
 	upclient "github.com/upbound/provider-upbound/internal/client"
 	"github.com/upbound/provider-upbound/internal/client/repository"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/config"
@@ -53,18 +54,19 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*repov1alpha1.Repository)
+	cr, ok := mg.(*repov1beta1.Repository)
 	if !ok {
 		return nil, errors.New(errNotRepository)
 	}
 
-	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	cfg, profile, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
 	return &external{
 		repositories: &sdkClient{upstream: repositories.NewClient(cfg)},
+		organization: profile.Account,
 	}, nil
 }
 
@@ -77,10 +79,14 @@ func (e *external) Disconnect(_ context.Context) error {
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
 	repositories RepoClient
+
+	// organization is the organization of the ProviderConfig, which
+	// repositories belong to unless they specify one.
+	organization string
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*repov1alpha1.Repository)
+	cr, ok := mg.(*repov1beta1.Repository)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotRepository)
 	}
@@ -89,7 +95,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, nil
 	}
 
-	resp, err := e.repositories.Get(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr))
+	resp, err := e.repositories.Get(ctx, e.organizationName(cr), meta.GetExternalName(cr))
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get repository")
 	}
@@ -100,28 +106,29 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(v1.Available())
 	cr.Status.AtProvider.RepositoryObservation = repository.StatusFromResponse(repoList.Repositories[0])
+	cr.Status.AtProvider.OrganizationName = e.organizationName(cr)
 
-	publishPolicy := repositories.PublishPolicy("draft")
-	if cr.Spec.ForProvider.Publish {
-		publishPolicy = "publish"
-	}
+	li := lateInitialize(&cr.Spec.ForProvider, resp.Repository)
+
+	publishPolicy := repositories.PublishPolicy(ptr.Deref(cr.Spec.ForProvider.PublishPolicy, repov1beta1.PublishPolicyDraft))
 
-	resourceUpToDate := cr.Spec.ForProvider.Public == resp.Public || ptr.Deref(resp.Publish, "") == publishPolicy
+	resourceUpToDate := ptr.Deref(cr.Spec.ForProvider.Public, false) == resp.Public || ptr.Deref(resp.Publish, "") == publishPolicy
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: resourceUpToDate,
+		ResourceExists:          true,
+		ResourceUpToDate:        resourceUpToDate,
+		ResourceLateInitialized: li,
 	}, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*repov1alpha1.Repository)
+	cr, ok := mg.(*repov1beta1.Repository)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotRepository)
 	}
-	visibility := createOrUpdatePublic(cr.Spec.ForProvider.Public)
-	publishPolicy := createOrUpdatePublish(cr.Spec.ForProvider.Publish)
-	err := e.repositories.CreateOrUpdateWithOptions(ctx, cr.Spec.ForProvider.OrganizationName, cr.Spec.ForProvider.Name, visibility, publishPolicy)
+	visibility := createOrUpdatePublic(ptr.Deref(cr.Spec.ForProvider.Public, false))
+	publishPolicy := createOrUpdatePublish(ptr.Deref(cr.Spec.ForProvider.PublishPolicy, repov1beta1.PublishPolicyDraft))
+	err := e.repositories.CreateOrUpdateWithOptions(ctx, e.organizationName(cr), cr.Spec.ForProvider.Name, visibility, publishPolicy)
 	if err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create repository")
 	}
@@ -130,14 +137,14 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*repov1alpha1.Repository)
+	cr, ok := mg.(*repov1beta1.Repository)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotRepository)
 	}
-	visibility := createOrUpdatePublic(cr.Spec.ForProvider.Public)
-	publishPolicy := createOrUpdatePublish(cr.Spec.ForProvider.Publish)
+	visibility := createOrUpdatePublic(ptr.Deref(cr.Spec.ForProvider.Public, false))
+	publishPolicy := createOrUpdatePublish(ptr.Deref(cr.Spec.ForProvider.PublishPolicy, repov1beta1.PublishPolicyDraft))
 
-	err := e.repositories.CreateOrUpdateWithOptions(ctx, cr.Spec.ForProvider.OrganizationName, cr.Spec.ForProvider.Name, visibility, publishPolicy)
+	err := e.repositories.CreateOrUpdateWithOptions(ctx, e.organizationName(cr), cr.Spec.ForProvider.Name, visibility, publishPolicy)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot update repository")
 	}
@@ -146,12 +153,18 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*repov1alpha1.Repository)
+	cr, ok := mg.(*repov1beta1.Repository)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotRepository)
 	}
 
-	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, e.repositories.Delete(ctx, cr.Spec.ForProvider.OrganizationName, meta.GetExternalName(cr))), "cannot delete repository")
+	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, e.repositories.Delete(ctx, e.organizationName(cr), meta.GetExternalName(cr))), "cannot delete repository")
+}
+
+// organizationName returns the name of the organization of the supplied
+// Repository, which defaults to the organization of the ProviderConfig.
+func (e *external) organizationName(cr *repov1beta1.Repository) string {
+	return cmp.Or(cr.Spec.ForProvider.OrganizationName, e.organization)
 }
 
 func createOrUpdatePublic(isPublic bool) repositories.CreateOrUpdateOption {
@@ -162,10 +175,26 @@ func createOrUpdatePublic(isPublic bool) repositories.CreateOrUpdateOption {
 	return private
 }
 
-func createOrUpdatePublish(publish bool) repositories.CreateOrUpdateOption {
+func createOrUpdatePublish(policy string) repositories.CreateOrUpdateOption {
 	publishPolicy := repositories.WithDraft()
-	if publish {
+	if policy == repov1beta1.PublishPolicyPublish {
 		publishPolicy = repositories.WithPublish()
 	}
 	return publishPolicy
 }
+
+// lateInitialize sets the visibility and publish policy of the supplied
+// parameters from the supplied repository if they are unset. It returns true
+// if any of them was set.
+func lateInitialize(p *repov1beta1.RepositoryParameters, r repositories.Repository) bool {
+	li := false
+	if p.Public == nil {
+		p.Public = ptr.To(r.Public)
+		li = true
+	}
+	if p.PublishPolicy == nil && r.Publish != nil {
+		p.PublishPolicy = ptr.To(string(*r.Publish))
+		li = true
+	}
+	return li
+}
@@ -14,7 +14,7 @@ import (
 
 	"github.com/upbound/up-sdk-go/service/repositories"
 
-	"github.com/upbound/provider-upbound/apis/namespaced/repository/v1alpha1"
+	"github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
 )
 
 func TestObserve(t *testing.T) {
@@ -27,13 +27,14 @@ func TestObserve(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		setupMocks func(m *mockClient)
-		args       args
-		want       want
+		setupMocks   func(m *mockClient)
+		organization string
+		args         args
+		want         want
 	}{
 		"ExternalNameEmpty": {
 			args: args{
-				mg: &v1alpha1.Repository{},
+				mg: &v1beta1.Repository{},
 			},
 			want: want{
 				o: managed.ExternalObservation{
@@ -49,9 +50,9 @@ func TestObserve(t *testing.T) {
 				}
 			},
 			args: args{
-				mg: &v1alpha1.Repository{
-					Spec: v1alpha1.RepositorySpec{
-						ForProvider: v1alpha1.RepositoryParameters{
+				mg: &v1beta1.Repository{
+					Spec: v1beta1.RepositorySpec{
+						ForProvider: v1beta1.RepositoryParameters{
 							OrganizationName: "org",
 						},
 					},
@@ -74,12 +75,12 @@ func TestObserve(t *testing.T) {
 				}
 			},
 			args: args{
-				mg: &v1alpha1.Repository{
-					Spec: v1alpha1.RepositorySpec{
-						ForProvider: v1alpha1.RepositoryParameters{
+				mg: &v1beta1.Repository{
+					Spec: v1beta1.RepositorySpec{
+						ForProvider: v1beta1.RepositoryParameters{
 							OrganizationName: "org",
-							Public:           true,
-							Publish:          true,
+							Public:           ptr.To(true),
+							PublishPolicy:    ptr.To(v1beta1.PublishPolicyPublish),
 						},
 					},
 					ObjectMeta: v1.ObjectMeta{
@@ -107,12 +108,12 @@ func TestObserve(t *testing.T) {
 				}
 			},
 			args: args{
-				mg: &v1alpha1.Repository{
-					Spec: v1alpha1.RepositorySpec{
-						ForProvider: v1alpha1.RepositoryParameters{
+				mg: &v1beta1.Repository{
+					Spec: v1beta1.RepositorySpec{
+						ForProvider: v1beta1.RepositoryParameters{
 							OrganizationName: "org",
-							Public:           true,
-							Publish:          true,
+							Public:           ptr.To(true),
+							PublishPolicy:    ptr.To(v1beta1.PublishPolicyPublish),
 						},
 					},
 					ObjectMeta: v1.ObjectMeta{
@@ -128,6 +129,74 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"RepoImported": {
+			setupMocks: func(m *mockClient) {
+				m.getFn = func(ctx context.Context, org, repo string) (*repositories.RepositoryResponse, error) {
+					return &repositories.RepositoryResponse{
+						Repository: repositories.Repository{
+							Public:  true,
+							Publish: ptr.To(repositories.PublishPolicy("publish")),
+						},
+					}, nil
+				}
+			},
+			args: args{
+				mg: &v1beta1.Repository{
+					Spec: v1beta1.RepositorySpec{
+						ForProvider: v1beta1.RepositoryParameters{
+							OrganizationName: "org",
+						},
+					},
+					ObjectMeta: v1.ObjectMeta{
+						Annotations: map[string]string{"crossplane.io/external-name": "name"},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+				err: nil,
+			},
+		},
+		"DefaultOrganization": {
+			setupMocks: func(m *mockClient) {
+				m.getFn = func(ctx context.Context, org, repo string) (*repositories.RepositoryResponse, error) {
+					if org != "default" {
+						return nil, errors.New("wrong organization")
+					}
+					return &repositories.RepositoryResponse{
+						Repository: repositories.Repository{
+							Public:  true,
+							Publish: ptr.To(repositories.PublishPolicy("publish")),
+						},
+					}, nil
+				}
+			},
+			organization: "default",
+			args: args{
+				mg: &v1beta1.Repository{
+					Spec: v1beta1.RepositorySpec{
+						ForProvider: v1beta1.RepositoryParameters{
+							Public:        ptr.To(true),
+							PublishPolicy: ptr.To(v1beta1.PublishPolicyPublish),
+						},
+					},
+					ObjectMeta: v1.ObjectMeta{
+						Annotations: map[string]string{"crossplane.io/external-name": "name"},
+					},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -136,7 +205,7 @@ func TestObserve(t *testing.T) {
 			if tc.setupMocks != nil {
 				tc.setupMocks(mockClient)
 			}
-			e := &external{repositories: mockClient}
+			e := &external{repositories: mockClient, organization: tc.organization}
 
 			got, err := e.Observe(context.Background(), tc.args.mg)
 			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
@@ -24,7 +24,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
-	repov1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1alpha1"
+	repov1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
 	"github.com/upbound/provider-upbound/internal/features"
 )
 
@@ -35,13 +35,13 @@ func SetupGated(mgr ctrl.Manager, o xpcontroller.Options) error {
 		if err := setup(mgr, o); err != nil {
 			panic(err)
 		}
-	}, repov1alpha1.RepositoryGroupVersionKind)
+	}, repov1beta1.RepositoryGroupVersionKind)
 	return nil
 }
 
 // setup adds a controller that reconciles Repository managed resources.
 func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
-	name := managed.ControllerName(repov1alpha1.RepositoryGroupKind)
+	name := managed.ControllerName(repov1beta1.RepositoryGroupKind)
 	initializers := []managed.Initializer{managed.NewNameAsExternalName(mgr.GetClient())}
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnector(&connector{
@@ -59,13 +59,13 @@ func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(repov1alpha1.RepositoryGroupVersionKind),
+		resource.ManagedKind(repov1beta1.RepositoryGroupVersionKind),
 		reconcilerOpts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&repov1alpha1.Repository{}).
+		For(&repov1beta1.Repository{}).
 		Complete(r)
 }
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryaccesspolicy
+
+import (
+	"context"
+
+	"github.com/upbound/up-sdk-go/service/accounts"
+
+	"github.com/upbound/provider-upbound/internal/client/repositorypermission"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+)
+
+type mockPermissionClient struct {
+	createFn func(ctx context.Context, params *repositorypermission.CreateParameters) error
+	listFn   func(ctx context.Context, params *repositorypermission.ListParameters) ([]repositorypermission.Grant, error)
+	deleteFn func(ctx context.Context, params *repositorypermission.GetParameters) error
+}
+
+func (m *mockPermissionClient) Create(ctx context.Context, params *repositorypermission.CreateParameters) error {
+	return m.createFn(ctx, params)
+}
+
+func (m *mockPermissionClient) List(ctx context.Context, params *repositorypermission.ListParameters) ([]repositorypermission.Grant, error) {
+	return m.listFn(ctx, params)
+}
+
+func (m *mockPermissionClient) Delete(ctx context.Context, params *repositorypermission.GetParameters) error {
+	return m.deleteFn(ctx, params)
+}
+
+type mockTeamClient struct {
+	listFn func(ctx context.Context, orgID uint) ([]teams.Team, error)
+}
+
+func (m *mockTeamClient) List(ctx context.Context, orgID uint) ([]teams.Team, error) {
+	return m.listFn(ctx, orgID)
+}
+
+type mockAccountClient struct {
+	getFn func(ctx context.Context, name string) (*accounts.AccountResponse, error)
+}
+
+func (m *mockAccountClient) Get(ctx context.Context, name string) (*accounts.AccountResponse, error) {
+	return m.getFn(ctx, name)
+}
@@ -23,7 +23,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	repov1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1alpha1"
+	repov1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
 	"github.com/upbound/provider-upbound/internal/features"
 )
 
@@ -34,13 +34,13 @@ func SetupGated(mgr ctrl.Manager, o xpcontroller.Options) error {
 		if err := setup(mgr, o); err != nil {
 			panic(err)
 		}
-	}, repov1alpha1.PermissionGroupVersionKind)
+	}, repov1beta1.PermissionGroupVersionKind)
 	return nil
 }
 
 // setup adds a controller that reconciles Permission managed resources.
 func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
-	name := managed.ControllerName(repov1alpha1.PermissionGroupKind)
+	name := managed.ControllerName(repov1beta1.PermissionGroupKind)
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnector(&connector{
 			kube: mgr.GetClient(),
@@ -57,13 +57,13 @@ func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(repov1alpha1.PermissionGroupVersionKind),
+		resource.ManagedKind(repov1beta1.PermissionGroupVersionKind),
 		reconcilerOpts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&repov1alpha1.Permission{}).
+		For(&repov1beta1.Permission{}).
 		Complete(r)
 }
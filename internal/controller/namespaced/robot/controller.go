@@ -17,7 +17,9 @@ limitations under the License.
 package robot
 
 import (
+	"cmp"
 	"context"
+	"fmt"
 	"strconv"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
@@ -33,7 +35,7 @@ import (
 	"github.com/upbound/up-sdk-go/service/organizations"
 	"github.com/upbound/up-sdk-go/service/robots"
 
-	iamv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
 	upclient "github.com/upbound/provider-upbound/internal/client"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/config"
 )
@@ -47,6 +49,8 @@ const (
 // is called.
 type connector struct {
 	kube client.Client
+
+	requireAdoptionAnnotation bool
 }
 
 // Connect typically produces an ExternalClient by:
@@ -55,19 +59,22 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*iamv1alpha1.Robot)
+	cr, ok := mg.(*iamv1beta1.Robot)
 	if !ok {
 		return nil, errors.New(errNotRobot)
 	}
 
-	cfg, _, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
+	cfg, profile, err := upclient.NewConfig(ctx, c.kube, config.GetProviderConfigSpecFn(cr))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
 	return &external{
-		robots:        robots.NewClient(cfg),
-		organizations: organizations.NewClient(cfg),
+		robots:                    robots.NewClient(cfg),
+		organizations:             organizations.NewClient(cfg),
+		annotations:               managed.NewRetryingCriticalAnnotationUpdater(c.kube),
+		requireAdoptionAnnotation: c.requireAdoptionAnnotation,
+		organization:              profile.Account,
 	}, nil
 }
 
@@ -79,18 +86,29 @@ func (e *external) Disconnect(_ context.Context) error {
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	robots        *robots.Client
-	organizations *organizations.Client
+	robots        RobotClient
+	organizations OrganizationClient
+
+	// annotations persists the external name of an adopted robot.
+	annotations               managed.CriticalAnnotationUpdater
+	requireAdoptionAnnotation bool
+
+	// organization is the organization of the ProviderConfig, which owns
+	// robots unless they specify one.
+	organization string
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*iamv1alpha1.Robot)
+	cr, ok := mg.(*iamv1beta1.Robot)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotRobot)
 	}
 
 	if meta.GetExternalName(cr) == "" {
-		return managed.ExternalObservation{}, nil
+		adopted, err := e.adopt(ctx, cr)
+		if err != nil || !adopted {
+			return managed.ExternalObservation{}, err
+		}
 	}
 	id, err := uuid.Parse(meta.GetExternalName(cr))
 	if err != nil {
@@ -102,28 +120,23 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 	cr.Status.SetConditions(v1.Available())
 	cr.Status.AtProvider.ID = resp.ID.String()
+	cr.Status.AtProvider.OrganizationID = upclient.RelationshipID(resp.RelationshipSet, "owner")
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialize(&cr.Spec.ForProvider, resp),
 	}, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*iamv1alpha1.Robot)
+	cr, ok := mg.(*iamv1beta1.Robot)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotRobot)
 	}
-	id := ptr.Deref(cr.Spec.ForProvider.Owner.ID, "")
-	if id == "" {
-		if cr.Spec.ForProvider.Owner.Name == nil {
-			return managed.ExternalCreation{}, errors.New("organization name or id must be specified")
-		}
-		o, err := e.organizations.GetOrgID(ctx, *cr.Spec.ForProvider.Owner.Name)
-		if err != nil {
-			return managed.ExternalCreation{}, errors.Wrap(err, "cannot get organization id")
-		}
-		id = strconv.FormatUint(uint64(o), 10)
+	orgID, err := e.organizationID(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
 	}
 
 	resp, err := e.robots.Create(ctx, &robots.RobotCreateParameters{
@@ -135,7 +148,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			Owner: robots.RobotOwner{
 				Data: robots.RobotOwnerData{
 					Type: robots.RobotOwnerOrganization,
-					ID:   id,
+					ID:   strconv.FormatUint(uint64(orgID), 10),
 				},
 			},
 		},
@@ -153,7 +166,7 @@ func (e *external) Update(_ context.Context, _ resource.Managed) (managed.Extern
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*iamv1alpha1.Robot)
+	cr, ok := mg.(*iamv1beta1.Robot)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotRobot)
 	}
@@ -164,3 +177,90 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, e.robots.Delete(ctx, id)), "cannot delete robot")
 }
+
+// organizationID returns the ID of the organization that owns the supplied
+// Robot, which defaults to the organization of the ProviderConfig.
+func (e *external) organizationID(ctx context.Context, cr *iamv1beta1.Robot) (uint, error) {
+	if id := ptr.Deref(cr.Spec.ForProvider.OrganizationID, ""); id != "" {
+		o, err := strconv.ParseUint(id, 10, 0)
+		if err != nil {
+			return 0, errors.Wrap(err, "cannot parse organization id")
+		}
+		if cr.Spec.ForProvider.OrganizationName == nil {
+			return uint(o), nil
+		}
+		// ClusterProviderConfigs allow organizations by name, so the ID must
+		// not identify another organization.
+		byName, err := e.organizations.GetOrgID(ctx, *cr.Spec.ForProvider.OrganizationName)
+		if err != nil {
+			return 0, errors.Wrap(err, "cannot get organization id")
+		}
+		if byName != uint(o) {
+			return 0, errors.Errorf("organizationId %s is not the ID of organization %s", id, *cr.Spec.ForProvider.OrganizationName)
+		}
+		return uint(o), nil
+	}
+	name := cmp.Or(ptr.Deref(cr.Spec.ForProvider.OrganizationName, ""), e.organization)
+	if name == "" {
+		return 0, errors.New("organization name or id must be specified")
+	}
+	o, err := e.organizations.GetOrgID(ctx, name)
+	return o, errors.Wrap(err, "cannot get organization id")
+}
+
+// adopt looks for an existing robot with the name of the supplied Robot in
+// its organization before one is created. This prevents duplicates when a
+// manifest is applied again, e.g. after an etcd restore, or when the external
+// name of a created robot was not persisted. The robot is adopted only when
+// adoption is allowed; otherwise the Robot reports a conflict instead of
+// creating a duplicate.
+func (e *external) adopt(ctx context.Context, cr *iamv1beta1.Robot) (bool, error) {
+	if meta.WasDeleted(cr) {
+		// Never adopt a resource only to delete it.
+		return false, nil
+	}
+	orgID, err := e.organizationID(ctx, cr)
+	if err != nil {
+		return false, err
+	}
+	list, err := e.organizations.ListRobots(ctx, orgID)
+	if err != nil {
+		return false, errors.Wrap(err, "cannot list robots")
+	}
+	var found []organizations.Robot
+	for _, rb := range list {
+		if rb.Name == cr.Spec.ForProvider.Name {
+			found = append(found, rb)
+		}
+	}
+	if len(found) == 0 {
+		return false, nil
+	}
+	if !upclient.AdoptionAllowed(cr, e.requireAdoptionAnnotation) {
+		msg := fmt.Sprintf("robot %s already exists in organization %d, annotate with %s: \"true\" to adopt it", cr.Spec.ForProvider.Name, orgID, upclient.AnnotationKeyAdoptExisting)
+		cr.Status.SetConditions(upclient.Conflict(msg))
+		return false, errors.New(msg)
+	}
+	if len(found) > 1 {
+		msg := fmt.Sprintf("%d robots named %s exist in organization %d, set the external name to the ID of the one to adopt", len(found), cr.Spec.ForProvider.Name, orgID)
+		cr.Status.SetConditions(upclient.Conflict(msg))
+		return false, errors.New(msg)
+	}
+	meta.SetExternalName(cr, found[0].ID.String())
+	return true, errors.Wrap(e.annotations.UpdateCriticalAnnotations(ctx, cr), "cannot record adopted robot")
+}
+
+// lateInitialize sets the unset fields of the supplied parameters from the
+// supplied robot. The organization ID is only set if the organization name is
+// specified, so that robots of the organization of the ProviderConfig keep
+// following it. It returns true if any of them was set.
+func lateInitialize(p *iamv1beta1.RobotParameters, resp *robots.RobotResponse) bool {
+	name, _ := resp.AttributeSet["name"].(string)
+	description, _ := resp.AttributeSet["description"].(string)
+	li := upclient.LateInitializeString(&p.Name, name)
+	li = upclient.LateInitializeString(&p.Description, description) || li
+	if p.OrganizationName == nil {
+		return li
+	}
+	return upclient.LateInitialize(&p.OrganizationID, upclient.RelationshipID(resp.RelationshipSet, "owner")) || li
+}
@@ -24,7 +24,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
-	iamv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
 	"github.com/upbound/provider-upbound/internal/features"
 )
 
@@ -35,18 +35,26 @@ func SetupGated(mgr ctrl.Manager, o xpcontroller.Options) error {
 		if err := setup(mgr, o); err != nil {
 			panic(err)
 		}
-	}, iamv1alpha1.RobotGroupVersionKind)
+	}, iamv1beta1.RobotGroupVersionKind)
 	return nil
 }
 
 // setup adds a controller that reconciles Robot managed resources.
 func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
-	name := managed.ControllerName(iamv1alpha1.RobotGroupKind)
+	name := managed.ControllerName(iamv1beta1.RobotGroupKind)
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnector(&connector{
-			kube: mgr.GetClient(),
+			kube:                      mgr.GetClient(),
+			requireAdoptionAnnotation: o.Features.Enabled(features.RequireAdoptionAnnotation),
 		}),
 		managed.WithPollInterval(o.PollInterval),
+		// Robot IDs are assigned by the API, so the external name is not
+		// deterministic. The option bypasses the ExternalCreateIncomplete
+		// protection, which would require removing the creation-pending
+		// annotation by hand after a lost creation result. Observe looks the
+		// robot up by name instead, and a Robot whose creation is incomplete
+		// always adopts it rather than creating a duplicate.
+		managed.WithDeterministicExternalName(true),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithInitializers(),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -58,13 +66,13 @@ func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(iamv1alpha1.RobotGroupVersionKind),
+		resource.ManagedKind(iamv1beta1.RobotGroupVersionKind),
 		reconcilerOpts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&iamv1alpha1.Robot{}).
+		For(&iamv1beta1.Robot{}).
 		Complete(r)
 }
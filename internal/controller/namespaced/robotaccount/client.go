@@ -0,0 +1,66 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package robotaccount
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/tokens"
+
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
+	"github.com/upbound/provider-upbound/internal/client/token"
+)
+
+// RobotClient manages robots.
+type RobotClient interface {
+	Create(ctx context.Context, params *robots.RobotCreateParameters) (*robots.RobotResponse, error)
+	Get(ctx context.Context, id uuid.UUID) (*robots.RobotResponse, error)
+	ListTokens(ctx context.Context, id uuid.UUID) (*tokens.TokensResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// TokenClient manages tokens.
+type TokenClient interface {
+	Create(ctx context.Context, params *tokens.TokenCreateParameters) (*tokens.TokenResponse, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// OrganizationClient looks up organizations and their robots.
+type OrganizationClient interface {
+	GetOrgID(ctx context.Context, name string) (uint, error)
+	ListRobots(ctx context.Context, id uint) ([]organizations.Robot, error)
+}
+
+// MembershipClient adds robots to and removes them from teams.
+type MembershipClient interface {
+	Create(ctx context.Context, robotID string, params *robotteammembership.ResourceIdentifier) error
+	Delete(ctx context.Context, robotID string, params *robotteammembership.DeleteParameters) error
+}
+
+// SecretClient reads token credentials from and writes them to Secrets.
+type SecretClient interface {
+	Lookup(ctx context.Context, id string, refs ...types.NamespacedName) (token.Credentials, bool, error)
+	Publish(ctx context.Context, owner client.Object, kind schema.GroupVersionKind, ref types.NamespacedName, f iamv1alpha1common.TokenFormat, c token.Credentials, pr token.Profiler) error
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package robotaccount
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/robots"
+	"github.com/upbound/up-sdk-go/service/tokens"
+
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
+	"github.com/upbound/provider-upbound/internal/client/token"
+)
+
+type mockRobotClient struct {
+	createFn     func(ctx context.Context, params *robots.RobotCreateParameters) (*robots.RobotResponse, error)
+	getFn        func(ctx context.Context, id uuid.UUID) (*robots.RobotResponse, error)
+	listTokensFn func(ctx context.Context, id uuid.UUID) (*tokens.TokensResponse, error)
+	deleteFn     func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockRobotClient) Create(ctx context.Context, params *robots.RobotCreateParameters) (*robots.RobotResponse, error) {
+	return m.createFn(ctx, params)
+}
+
+func (m *mockRobotClient) Get(ctx context.Context, id uuid.UUID) (*robots.RobotResponse, error) {
+	return m.getFn(ctx, id)
+}
+
+func (m *mockRobotClient) ListTokens(ctx context.Context, id uuid.UUID) (*tokens.TokensResponse, error) {
+	return m.listTokensFn(ctx, id)
+}
+
+func (m *mockRobotClient) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.deleteFn(ctx, id)
+}
+
+type mockTokenClient struct {
+	createFn func(ctx context.Context, params *tokens.TokenCreateParameters) (*tokens.TokenResponse, error)
+	deleteFn func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *mockTokenClient) Create(ctx context.Context, params *tokens.TokenCreateParameters) (*tokens.TokenResponse, error) {
+	return m.createFn(ctx, params)
+}
+
+func (m *mockTokenClient) Delete(ctx context.Context, id uuid.UUID) error {
+	return m.deleteFn(ctx, id)
+}
+
+type mockOrganizationClient struct {
+	getOrgIDFn   func(ctx context.Context, name string) (uint, error)
+	listRobotsFn func(ctx context.Context, id uint) ([]organizations.Robot, error)
+}
+
+func (m *mockOrganizationClient) GetOrgID(ctx context.Context, name string) (uint, error) {
+	return m.getOrgIDFn(ctx, name)
+}
+
+func (m *mockOrganizationClient) ListRobots(ctx context.Context, id uint) ([]organizations.Robot, error) {
+	return m.listRobotsFn(ctx, id)
+}
+
+type mockMembershipClient struct {
+	createFn func(ctx context.Context, robotID string, params *robotteammembership.ResourceIdentifier) error
+	deleteFn func(ctx context.Context, robotID string, params *robotteammembership.DeleteParameters) error
+}
+
+func (m *mockMembershipClient) Create(ctx context.Context, robotID string, params *robotteammembership.ResourceIdentifier) error {
+	return m.createFn(ctx, robotID, params)
+}
+
+func (m *mockMembershipClient) Delete(ctx context.Context, robotID string, params *robotteammembership.DeleteParameters) error {
+	return m.deleteFn(ctx, robotID, params)
+}
+
+type mockSecretClient struct {
+	lookupFn  func(ctx context.Context, id string, refs ...types.NamespacedName) (token.Credentials, bool, error)
+	publishFn func(ctx context.Context, owner client.Object, kind schema.GroupVersionKind, ref types.NamespacedName, f iamv1alpha1common.TokenFormat, c token.Credentials, pr token.Profiler) error
+}
+
+func (m *mockSecretClient) Lookup(ctx context.Context, id string, refs ...types.NamespacedName) (token.Credentials, bool, error) {
+	return m.lookupFn(ctx, id, refs...)
+}
+
+func (m *mockSecretClient) Publish(ctx context.Context, owner client.Object, kind schema.GroupVersionKind, ref types.NamespacedName, f iamv1alpha1common.TokenFormat, c token.Credentials, pr token.Profiler) error {
+	return m.publishFn(ctx, owner, kind, ref, f, c, pr)
+}
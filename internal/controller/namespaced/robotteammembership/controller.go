@@ -20,6 +20,7 @@ import (
 	"context"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/pkg/errors"
@@ -28,7 +29,7 @@ import (
 
 	uperrors "github.com/upbound/up-sdk-go/errors"
 
-	iamv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
 	upclient "github.com/upbound/provider-upbound/internal/client"
 	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/config"
@@ -51,7 +52,7 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*iamv1alpha1.RobotTeamMembership)
+	cr, ok := mg.(*iamv1beta1.RobotTeamMembership)
 	if !ok {
 		return nil, errors.New(errNotRobotTeamMembership)
 	}
@@ -78,29 +79,40 @@ type external struct {
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*iamv1alpha1.RobotTeamMembership)
+	cr, ok := mg.(*iamv1beta1.RobotTeamMembership)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotRobotTeamMembership)
 	}
 
-	// NOTE(muvaf): External name annotation is not used since this is an attribute
-	// that binds to resources and the id of both are already under spec.
-
+	// The external name is of the form <robotID>/<teamID>. An imported
+	// membership may only have its external name set, in which case the IDs
+	// in spec are late-initialized from it.
+	li, err := lateInitialize(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 	tid := ptr.Deref(cr.Spec.ForProvider.TeamID, "")
 	rid := ptr.Deref(cr.Spec.ForProvider.RobotID, "")
 	if err := e.robotTeamMemberships.Get(ctx, rid, tid); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot get robot team ids")
 	}
+	if en := robotteammembership.ExternalName(rid, tid); meta.GetExternalName(cr) != en {
+		// Memberships created before the external name was set are named
+		// once they are observed.
+		meta.SetExternalName(cr, en)
+		li = true
+	}
 	cr.Status.SetConditions(v1.Available())
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: li,
 	}, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*iamv1alpha1.RobotTeamMembership)
+	cr, ok := mg.(*iamv1beta1.RobotTeamMembership)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotRobotTeamMembership)
 	}
@@ -112,6 +124,7 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}); err != nil {
 		return managed.ExternalCreation{}, errors.Wrap(err, "cannot create team membership for the robot")
 	}
+	meta.SetExternalName(cr, robotteammembership.ExternalName(rid, tid))
 	return managed.ExternalCreation{}, nil
 }
 
@@ -121,7 +134,7 @@ func (e *external) Update(_ context.Context, _ resource.Managed) (managed.Extern
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*iamv1alpha1.RobotTeamMembership)
+	cr, ok := mg.(*iamv1beta1.RobotTeamMembership)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotRobotTeamMembership)
 	}
@@ -131,3 +144,25 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	})
 	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "cannot delete robot team membership")
 }
+
+// lateInitialize sets the robot and team IDs of the supplied
+// RobotTeamMembership from its external name if they are unset. It returns
+// true if any of them was set.
+func lateInitialize(cr *iamv1beta1.RobotTeamMembership) (bool, error) {
+	en := meta.GetExternalName(cr)
+	if en == "" {
+		return false, nil
+	}
+	rid, tid, err := robotteammembership.ParseExternalName(en)
+	if err != nil {
+		return false, err
+	}
+	p := &cr.Spec.ForProvider
+	if (p.RobotID != nil && *p.RobotID != rid) || (p.TeamID != nil && *p.TeamID != tid) {
+		return false, errors.Errorf("external name %q does not match the robotId and teamId in spec", en)
+	}
+	li := p.RobotID == nil || p.TeamID == nil
+	p.RobotID = ptr.To(rid)
+	p.TeamID = ptr.To(tid)
+	return li, nil
+}
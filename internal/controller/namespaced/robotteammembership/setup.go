@@ -24,7 +24,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
-	iamv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
 	"github.com/upbound/provider-upbound/internal/features"
 )
 
@@ -35,13 +35,13 @@ func SetupGated(mgr ctrl.Manager, o xpcontroller.Options) error {
 		if err := setup(mgr, o); err != nil {
 			panic(err)
 		}
-	}, iamv1alpha1.RobotTeamMembershipGroupVersionKind)
+	}, iamv1beta1.RobotTeamMembershipGroupVersionKind)
 	return nil
 }
 
 // setup adds a controller that reconciles RobotTeamMembership managed resources.
 func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
-	name := managed.ControllerName(iamv1alpha1.RobotTeamMembershipKindAPIVersion)
+	name := managed.ControllerName(iamv1beta1.RobotTeamMembershipKindAPIVersion)
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnector(&connector{
 			kube: mgr.GetClient(),
@@ -58,13 +58,13 @@ func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(iamv1alpha1.RobotTeamMembershipGroupVersionKind),
+		resource.ManagedKind(iamv1beta1.RobotTeamMembershipGroupVersionKind),
 		reconcilerOpts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&iamv1alpha1.RobotTeamMembership{}).
+		For(&iamv1beta1.RobotTeamMembership{}).
 		Complete(r)
 }
@@ -21,12 +21,22 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/config"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/configuration"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/controlplane"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/controlplanepermission"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/organization"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/organizationinvite"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/organizationmember"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/repository"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/repositoryaccesspolicy"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/repositorypermission"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/robot"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/robotaccount"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/robotteammembership"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/team"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/token"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/user"
+	"github.com/upbound/provider-upbound/internal/controller/namespaced/userteammembership"
 )
 
 // Setup creates all Upbound controllers related to namespaced MRs
@@ -35,12 +45,22 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	for _, setup := range []func(ctrl.Manager, controller.Options) error{
 		config.SetupClusterScopedGated,
 		config.SetupNamespacedGated,
+		configuration.SetupGated,
+		controlplane.SetupGated,
+		controlplanepermission.SetupGated,
+		organization.SetupGated,
+		organizationinvite.SetupGated,
+		organizationmember.SetupGated,
 		repository.SetupGated,
+		repositoryaccesspolicy.SetupGated,
 		repositorypermission.SetupGated,
 		robot.SetupGated,
+		robotaccount.SetupGated,
 		robotteammembership.SetupGated,
 		team.SetupGated,
 		token.SetupGated,
+		user.SetupGated,
+		userteammembership.SetupGated,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
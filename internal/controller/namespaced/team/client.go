@@ -0,0 +1,45 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package team
+
+import (
+	"context"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+)
+
+// TeamClient manages teams.
+type TeamClient interface {
+	Get(ctx context.Context, id string) (*teams.GetResponse, error)
+	Create(ctx context.Context, params *teams.CreateParameters) (*teams.CreateResponse, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, orgID uint) ([]teams.Team, error)
+}
+
+// OrganizationClient lists the robots of organizations.
+type OrganizationClient interface {
+	ListRobots(ctx context.Context, id uint) ([]organizations.Robot, error)
+}
+
+// MembershipClient adds robots to and removes them from teams.
+type MembershipClient interface {
+	Create(ctx context.Context, robotID string, params *robotteammembership.ResourceIdentifier) error
+	Delete(ctx context.Context, robotID string, params *robotteammembership.DeleteParameters) error
+}
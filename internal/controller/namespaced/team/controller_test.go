@@ -0,0 +1,448 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package team
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	uperrors "github.com/upbound/up-sdk-go/errors"
+	"github.com/upbound/up-sdk-go/service/organizations"
+
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
+	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
+	"github.com/upbound/provider-upbound/internal/client/teams"
+)
+
+// team returns a Team named devs in organization 1 with the supplied
+// annotations.
+func team(annotations map[string]string) *iamv1beta1.Team {
+	return &iamv1beta1.Team{
+		ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+		Spec: iamv1beta1.TeamSpec{ForProvider: iamv1beta1.TeamParameters{
+			Name:           "devs",
+			OrganizationID: ptr.To("1"),
+		}},
+	}
+}
+
+// created returns a created Team with the supplied robot members.
+func created(exclusive bool, robots ...string) *iamv1beta1.Team {
+	cr := team(nil)
+	meta.SetExternalName(cr, teamID.String())
+	cr.Spec.ForProvider.Exclusive = exclusive
+	cr.Spec.ForProvider.RobotMembers = []iamv1beta1.TeamRobotMember{}
+	for _, id := range robots {
+		cr.Spec.ForProvider.RobotMembers = append(cr.Spec.ForProvider.RobotMembers, iamv1beta1.TeamRobotMember{RobotID: ptr.To(id)})
+	}
+	return cr
+}
+
+var (
+	teamID  = uuid.MustParse("00000000-0000-0000-0000-00000000000f")
+	robotA  = uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	robotB  = uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	robotC  = uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+	members = []organizations.Robot{
+		{ID: robotA, TeamIDs: []uuid.UUID{teamID}},
+		{ID: robotB, TeamIDs: []uuid.UUID{uuid.New(), teamID}},
+		{ID: robotC, TeamIDs: []uuid.UUID{uuid.New()}},
+	}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		externalName string
+		reason       xpv1.ConditionReason
+		robotMembers []string
+		err          error
+	}
+
+	conflict := `team devs already exists in organization 1, annotate with upbound.io/adopt-existing: "true" to adopt it`
+	incomplete := team(nil)
+	meta.SetExternalCreatePending(incomplete, time.Now())
+
+	cases := map[string]struct {
+		reason  string
+		require bool
+		teams   []teams.Team
+		robots  []organizations.Robot
+		mg      *iamv1beta1.Team
+		want    want
+	}{
+		"NotFound": {
+			reason: "A Team without an external name does not exist when no team has its name.",
+			teams:  []teams.Team{{ID: "other", Name: "ops"}},
+			mg:     team(nil),
+		},
+		"AdoptByName": {
+			reason:  "A Team annotated to adopt takes over the team with its name.",
+			require: true,
+			teams:   []teams.Team{{ID: "other", Name: "ops"}, {ID: "existing", Name: "devs"}},
+			mg:      team(map[string]string{upclient.AnnotationKeyAdoptExisting: "true"}),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: "existing",
+				reason:       xpv1.ReasonAvailable,
+			},
+		},
+		"AdoptionConflict": {
+			reason:  "A Team that may not adopt reports a conflict instead of creating a duplicate.",
+			require: true,
+			teams:   []teams.Team{{ID: "existing", Name: "devs"}},
+			mg:      team(nil),
+			want: want{
+				reason: upclient.ReasonConflict,
+				err:    errors.New(conflict),
+			},
+		},
+		"AdoptionOptedOut": {
+			reason: "A Team that opts out of adoption reports a conflict even when no annotation is required.",
+			teams:  []teams.Team{{ID: "existing", Name: "devs"}},
+			mg:     team(map[string]string{upclient.AnnotationKeyAdoptExisting: "false"}),
+			want: want{
+				reason: upclient.ReasonConflict,
+				err:    errors.New(conflict),
+			},
+		},
+		"CreateIncomplete": {
+			reason:  "A Team whose creation result was lost adopts the team it likely created without an annotation.",
+			require: true,
+			teams:   []teams.Team{{ID: "existing", Name: "devs"}},
+			mg:      incomplete,
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				externalName: "existing",
+				reason:       xpv1.ReasonAvailable,
+			},
+		},
+		"Ambiguous": {
+			reason: "A Team does not guess which of several teams with its name to adopt.",
+			teams:  []teams.Team{{ID: "a", Name: "devs"}, {ID: "b", Name: "devs"}},
+			mg:     team(nil),
+			want: want{
+				reason: upclient.ReasonConflict,
+				err:    errors.New("2 teams named devs exist in organization 1, set the external name to the ID of the one to adopt"),
+			},
+		},
+		"RobotMembersUnmanaged": {
+			reason: "A Team that does not list robot members does not observe them.",
+			mg: func() *iamv1beta1.Team {
+				cr := created(false)
+				cr.Spec.ForProvider.RobotMembers = nil
+				return cr
+			}(),
+			want: want{
+				o:      managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason: xpv1.ReasonAvailable,
+			},
+		},
+		"RobotMembersUpToDate": {
+			reason: "A Team whose listed robots are its members is up to date.",
+			robots: members,
+			mg:     created(true, robotA.String(), robotB.String()),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason:       xpv1.ReasonAvailable,
+				robotMembers: []string{robotA.String(), robotB.String()},
+			},
+		},
+		"RobotMemberMissing": {
+			reason: "A Team that lists a robot that is not its member is not up to date.",
+			robots: members,
+			mg:     created(false, robotA.String(), robotC.String()),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true},
+				reason:       xpv1.ReasonAvailable,
+				robotMembers: []string{robotA.String(), robotB.String()},
+			},
+		},
+		"UnlistedRobotMemberKept": {
+			reason: "A Team that is not exclusive is up to date with members it does not list.",
+			robots: members,
+			mg:     created(false, robotA.String()),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				reason:       xpv1.ReasonAvailable,
+				robotMembers: []string{robotA.String(), robotB.String()},
+			},
+		},
+		"UnlistedRobotMemberDrift": {
+			reason: "An exclusive Team with members it does not list is not up to date.",
+			robots: members,
+			mg:     created(true, robotA.String()),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true},
+				reason:       xpv1.ReasonAvailable,
+				robotMembers: []string{robotA.String(), robotB.String()},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var recorded string
+			e := &external{
+				teams: &mockTeamClient{
+					listFn: func(_ context.Context, orgID uint) ([]teams.Team, error) {
+						if orgID != 1 {
+							return nil, errors.Errorf("unexpected organization %d", orgID)
+						}
+						return tc.teams, nil
+					},
+					getFn: func(_ context.Context, _ string) (*teams.GetResponse, error) {
+						return &teams.GetResponse{}, nil
+					},
+				},
+				orgs: &mockOrganizationClient{
+					listRobotsFn: func(_ context.Context, orgID uint) ([]organizations.Robot, error) {
+						if orgID != 1 {
+							return nil, errors.Errorf("unexpected organization %d", orgID)
+						}
+						return tc.robots, nil
+					},
+				},
+				annotations: managed.CriticalAnnotationUpdateFn(func(_ context.Context, o client.Object) error {
+					recorded = meta.GetExternalName(o)
+					return nil
+				}),
+				requireAdoptionAnnotation: tc.require,
+			}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, recorded); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want recorded external name, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.reason, tc.mg.GetCondition(xpv1.TypeReady).Reason); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want ready reason, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.robotMembers, tc.mg.Status.AtProvider.RobotMembers); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want observed robot members, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRobotMemberChanges(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired   []iamv1beta1.TeamRobotMember
+		exclusive bool
+		observed  []string
+		want      want
+	}{
+		"UpToDate": {
+			desired:  []iamv1beta1.TeamRobotMember{{RobotID: ptr.To("a")}},
+			observed: []string{"a"},
+		},
+		"Missing": {
+			desired:  []iamv1beta1.TeamRobotMember{{RobotID: ptr.To("a")}, {RobotID: ptr.To("b")}},
+			observed: []string{"a"},
+			want:     want{add: []string{"b"}},
+		},
+		"UnlistedKept": {
+			desired:  []iamv1beta1.TeamRobotMember{{RobotID: ptr.To("a")}},
+			observed: []string{"a", "c"},
+		},
+		"UnlistedRemovedWhenExclusive": {
+			desired:   []iamv1beta1.TeamRobotMember{{RobotID: ptr.To("a")}},
+			exclusive: true,
+			observed:  []string{"a", "c"},
+			want:      want{remove: []string{"c"}},
+		},
+		"AllRemovedWhenExclusiveAndEmpty": {
+			desired:   []iamv1beta1.TeamRobotMember{},
+			exclusive: true,
+			observed:  []string{"a", "c"},
+			want:      want{remove: []string{"a", "c"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := robotMemberChanges(tc.desired, tc.exclusive, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("robotMemberChanges(...): -want add, +got add:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("robotMemberChanges(...): -want remove, +got remove:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		memberships *mockMembershipClient
+		mg          *iamv1beta1.Team
+		want        want
+	}{
+		"RobotMembersUnmanaged": {
+			mg: &iamv1beta1.Team{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyExternalName: "team"}},
+				Status:     iamv1beta1.TeamStatus{AtProvider: iamv1beta1.TeamObservation{RobotMembers: []string{"a"}}},
+			},
+		},
+		"AddAndRemove": {
+			mg: &iamv1beta1.Team{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyExternalName: "team"}},
+				Spec: iamv1beta1.TeamSpec{ForProvider: iamv1beta1.TeamParameters{
+					RobotMembers: []iamv1beta1.TeamRobotMember{{RobotID: ptr.To("a")}, {RobotID: ptr.To("b")}},
+					Exclusive:    true,
+				}},
+				Status: iamv1beta1.TeamStatus{AtProvider: iamv1beta1.TeamObservation{RobotMembers: []string{"b", "c"}}},
+			},
+			want: want{calls: []string{"add a to team", "remove c from team"}},
+		},
+		"UnlistedKept": {
+			mg: &iamv1beta1.Team{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyExternalName: "team"}},
+				Spec: iamv1beta1.TeamSpec{ForProvider: iamv1beta1.TeamParameters{
+					RobotMembers: []iamv1beta1.TeamRobotMember{{RobotID: ptr.To("a")}},
+				}},
+				Status: iamv1beta1.TeamStatus{AtProvider: iamv1beta1.TeamObservation{RobotMembers: []string{"c"}}},
+			},
+			want: want{calls: []string{"add a to team"}},
+		},
+		"RemovedAlready": {
+			memberships: &mockMembershipClient{
+				deleteFn: func(_ context.Context, _ string, _ *robotteammembership.DeleteParameters) error {
+					return &uperrors.Error{Status: http.StatusNotFound}
+				},
+			},
+			mg: &iamv1beta1.Team{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyExternalName: "team"}},
+				Spec: iamv1beta1.TeamSpec{ForProvider: iamv1beta1.TeamParameters{
+					RobotMembers: []iamv1beta1.TeamRobotMember{},
+					Exclusive:    true,
+				}},
+				Status: iamv1beta1.TeamStatus{AtProvider: iamv1beta1.TeamObservation{RobotMembers: []string{"c"}}},
+			},
+		},
+		"AddFailed": {
+			memberships: &mockMembershipClient{
+				createFn: func(_ context.Context, _ string, _ *robotteammembership.ResourceIdentifier) error {
+					return errBoom
+				},
+			},
+			mg: &iamv1beta1.Team{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyExternalName: "team"}},
+				Spec: iamv1beta1.TeamSpec{ForProvider: iamv1beta1.TeamParameters{
+					RobotMembers: []iamv1beta1.TeamRobotMember{{RobotID: ptr.To("a")}},
+				}},
+			},
+			want: want{err: errors.Wrap(errBoom, "failed to add robot a to team")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			m := &mockMembershipClient{
+				createFn: func(_ context.Context, robotID string, params *robotteammembership.ResourceIdentifier) error {
+					calls = append(calls, fmt.Sprintf("add %s to %s", robotID, params.ID))
+					return nil
+				},
+				deleteFn: func(_ context.Context, robotID string, params *robotteammembership.DeleteParameters) error {
+					calls = append(calls, fmt.Sprintf("remove %s from %s", robotID, params.ID))
+					return nil
+				},
+			}
+			if tc.memberships != nil {
+				m = tc.memberships
+			}
+			e := &external{memberships: m}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		deleteErr error
+		want      error
+	}{
+		"Deleted": {},
+		"NotFound": {
+			deleteErr: &uperrors.Error{Status: http.StatusNotFound},
+		},
+		"Failed": {
+			deleteErr: errBoom,
+			want:      errors.Wrap(errBoom, "failed to delete team"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted string
+			e := &external{teams: &mockTeamClient{
+				deleteFn: func(_ context.Context, id string) error {
+					deleted = id
+					return tc.deleteErr
+				},
+			}}
+			mg := &iamv1beta1.Team{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.AnnotationKeyExternalName: "team"}}}
+			_, err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if deleted != "team" {
+				t.Errorf("Delete(...): deleted team %q, want %q", deleted, "team")
+			}
+		})
+	}
+}
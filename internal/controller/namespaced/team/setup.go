@@ -24,7 +24,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 
-	iamv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
 	"github.com/upbound/provider-upbound/internal/features"
 )
 
@@ -35,18 +35,27 @@ func SetupGated(mgr ctrl.Manager, o xpcontroller.Options) error {
 		if err := setup(mgr, o); err != nil {
 			panic(err)
 		}
-	}, iamv1alpha1.TeamGroupVersionKind)
+	}, iamv1beta1.TeamGroupVersionKind)
 	return nil
 }
 
 // setup adds a controller that reconciles Team managed resources.
 func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
-	name := managed.ControllerName(iamv1alpha1.TeamGroupKind)
+	name := managed.ControllerName(iamv1beta1.TeamGroupKind)
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithExternalConnector(&connector{
-			kube: mgr.GetClient(),
+			kube:                      mgr.GetClient(),
+			requireAdoptionAnnotation: o.Features.Enabled(features.RequireAdoptionAnnotation),
 		}),
 		managed.WithPollInterval(o.PollInterval),
+		// Team IDs are assigned by the API, so the external name is not
+		// deterministic. The option is still set to bypass the
+		// ExternalCreateIncomplete protection, which would otherwise stop
+		// reconciling until the creation-pending annotation is removed by
+		// hand. It is safe since Observe looks teams up by name before one is
+		// created, and a Team whose creation is incomplete always adopts the
+		// team it finds instead of creating a duplicate.
+		managed.WithDeterministicExternalName(true),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithInitializers(),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -58,13 +67,13 @@ func setup(mgr ctrl.Manager, o xpcontroller.Options) error {
 	}
 
 	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(iamv1alpha1.TeamGroupVersionKind),
+		resource.ManagedKind(iamv1beta1.TeamGroupVersionKind),
 		reconcilerOpts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		WithEventFilter(resource.DesiredStateChanged()).
-		For(&iamv1alpha1.Team{}).
+		For(&iamv1beta1.Team{}).
 		Complete(r)
 }
@@ -19,6 +19,7 @@ package token
 import (
 	"context"
 	"fmt"
+	"time"
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
@@ -26,6 +27,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -34,8 +36,10 @@ import (
 	"github.com/upbound/up-sdk-go/service/robots"
 	"github.com/upbound/up-sdk-go/service/tokens"
 
-	iamv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1alpha1"
+	iamv1alpha1common "github.com/upbound/provider-upbound/apis/common/iam/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
 	upclient "github.com/upbound/provider-upbound/internal/client"
+	"github.com/upbound/provider-upbound/internal/client/token"
 	"github.com/upbound/provider-upbound/internal/controller/namespaced/config"
 )
 
@@ -56,7 +60,7 @@ type connector struct {
 // 3. Getting the credentials specified by the ProviderConfig.
 // 4. Using the credentials to form a client.
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	cr, ok := mg.(*iamv1alpha1.Token)
+	cr, ok := mg.(*iamv1beta1.Token)
 	if !ok {
 		return nil, errors.New(errNotToken)
 	}
@@ -67,9 +71,12 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	}
 
 	return &external{
-		tokens:   tokens.NewClient(cfg),
-		accounts: accounts.NewClient(cfg),
-		robots:   robots.NewClient(cfg),
+		tokens:      tokens.NewClient(cfg),
+		accounts:    accounts.NewClient(cfg),
+		robots:      robots.NewClient(cfg),
+		annotations: managed.NewRetryingCriticalAnnotationUpdater(c.kube),
+		secrets:     token.NewSecretPublisher(c.kube),
+		profiles:    token.NewProfiler(c.kube, config.GetProviderConfigSpecFn(cr)),
 	}, nil
 }
 
@@ -86,10 +93,23 @@ type external struct {
 	tokens   *tokens.Client
 	accounts *accounts.Client
 	robots   *robots.Client
+
+	// annotations persists the external name of a rotated token and the
+	// previous token, which would otherwise be lost since only status is
+	// written after Update.
+	annotations managed.CriticalAnnotationUpdater
+
+	// secrets writes the credentials to the output Secret, whose type depends
+	// on the output format.
+	secrets *token.SecretPublisher
+
+	// profiles logs in with the credentials when they are written as an up
+	// CLI profile.
+	profiles token.Profiler
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
-	cr, ok := mg.(*iamv1alpha1.Token)
+	cr, ok := mg.(*iamv1beta1.Token)
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotToken)
 	}
@@ -105,19 +125,73 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, err), "failed to get token")
 	}
+	// The first token's issue time is not recorded in status by Create, so we
+	// fall back to the time its creation was persisted.
+	issued := meta.GetExternalCreateSucceeded(cr)
+	if issued.IsZero() {
+		issued = time.Now()
+	}
+	token.UpdateObservation(&cr.Status.AtProvider.TokenObservation, cr.Spec.ForProvider.Rotation, resp, issued)
+	token.RestorePrevious(&cr.Status.AtProvider.TokenObservation, cr)
+
+	// The JWT is only returned on creation, so the credentials are read back
+	// from the Secrets they were written to in order to apply format changes.
+	var cd managed.ConnectionDetails
+	creds, ok, err := e.secrets.Lookup(ctx, uid.String(), credentialSources(cr)...)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, "cannot look up token credentials")
+	}
+	if ok {
+		if cd, err = token.ConnectionDetails(ctx, outputFormat(cr), creds, e.profiles); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, "cannot format token credentials")
+		}
+		if err := e.publishOutput(ctx, cr, creds); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, "cannot publish token output")
+		}
+	}
 	cr.Status.SetConditions(v1.Available())
+
+	now := time.Now()
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: resp.AttributeSet["name"] == cr.Spec.ForProvider.Name,
+		ConnectionDetails: cd,
+		ResourceExists:    true,
+		ResourceUpToDate: resp.AttributeSet["name"] == cr.Spec.ForProvider.Name &&
+			!token.RotationDue(cr.Status.AtProvider.TokenObservation, now) &&
+			!token.PreviousExpired(cr.Status.AtProvider.TokenObservation, now),
+		ResourceLateInitialized: upclient.LateInitialize(&cr.Spec.ForProvider.Owner.ID, upclient.RelationshipID(resp.RelationshipSet, "owner")),
 	}, nil
 }
 
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
-	cr, ok := mg.(*iamv1alpha1.Token)
+	cr, ok := mg.(*iamv1beta1.Token)
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errNotToken)
 	}
-	resp, err := e.tokens.Create(ctx, &tokens.TokenCreateParameters{
+	resp, err := e.issue(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create token")
+	}
+	meta.SetExternalName(cr, resp.ID.String())
+
+	creds := token.CredentialsFrom(resp)
+	cd, err := token.ConnectionDetails(ctx, outputFormat(cr), creds, e.profiles)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot format token credentials")
+	}
+	if err := e.publishOutput(ctx, cr, creds); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, "cannot publish token output")
+	}
+
+	return managed.ExternalCreation{
+		// Optionally return any details that may be required to connect to the
+		// external resource. These will be stored as the connection secret.
+		ConnectionDetails: cd,
+	}, nil
+}
+
+// issue creates a new token as described by the supplied Token.
+func (e *external) issue(ctx context.Context, cr *iamv1beta1.Token) (*tokens.TokenResponse, error) {
+	return e.tokens.Create(ctx, &tokens.TokenCreateParameters{
 		Attributes: tokens.TokenAttributes{
 			Name: cr.Spec.ForProvider.Name,
 		},
@@ -130,43 +204,128 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 			},
 		},
 	})
-	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, "failed to create token")
-	}
-	meta.SetExternalName(cr, resp.ID.String())
-
-	return managed.ExternalCreation{
-		// Optionally return any details that may be required to connect to the
-		// external resource. These will be stored as the connection secret.
-		ConnectionDetails: managed.ConnectionDetails{
-			"token": []byte(fmt.Sprint(resp.DataSet.Meta["jwt"])),
-			"id":    []byte(fmt.Sprint(resp.ID.String())),
-		},
-	}, nil
 }
 
 func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	cr, ok := mg.(*iamv1alpha1.Token)
+	cr, ok := mg.(*iamv1beta1.Token)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotToken)
 	}
 
-	_, err := e.tokens.Update(ctx, &tokens.TokenUpdateParameters{
+	uid, err := uuid.Parse(meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot parse external name as UUID")
+	}
+	if _, err := e.tokens.Update(ctx, &tokens.TokenUpdateParameters{
+		ID: uid,
 		Attributes: tokens.TokenAttributes{
 			Name: cr.Spec.ForProvider.Name,
 		},
-	})
-	return managed.ExternalUpdate{}, errors.Wrap(err, "failed to update token")
+	}); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to update token")
+	}
+
+	now := time.Now()
+	obs := cr.Status.AtProvider.TokenObservation
+	// Only a single previous token is kept, so a rotation that is due before
+	// the overlap window of the last one has passed deletes it early.
+	if token.PreviousExpired(obs, now) || (token.RotationDue(obs, now) && obs.PreviousTokenID != "") {
+		if err := e.deleteToken(ctx, obs.PreviousTokenID); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, "failed to delete previous token")
+		}
+		token.ClearPrevious(&obs)
+		if token.SetPreviousAnnotations(cr, obs) {
+			if err := e.annotations.UpdateCriticalAnnotations(ctx, cr); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, "failed to forget previous token")
+			}
+		}
+		cr.Status.AtProvider.TokenObservation = obs
+	}
+	if !token.RotationDue(obs, now) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	resp, err := e.issue(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to rotate token")
+	}
+	token.RecordRotation(&obs, cr.Spec.ForProvider.Rotation, resp, now)
+	meta.SetExternalName(cr, resp.ID.String())
+	token.SetPreviousAnnotations(cr, obs)
+	if err := e.annotations.UpdateCriticalAnnotations(ctx, cr); err != nil {
+		// Do not leak a token we could not record.
+		_ = e.deleteToken(ctx, resp.ID.String())
+		return managed.ExternalUpdate{}, errors.Wrap(err, "failed to record rotated token")
+	}
+	// Persisting the annotations resets the status to what is stored in the
+	// API server, so the rotation is recorded in it only afterwards.
+	cr.Status.AtProvider.TokenObservation = obs
+
+	creds := token.CredentialsFrom(resp)
+	cd, err := token.ConnectionDetails(ctx, outputFormat(cr), creds, e.profiles)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot format token credentials")
+	}
+	if err := e.publishOutput(ctx, cr, creds); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, "cannot publish token output")
+	}
+
+	return managed.ExternalUpdate{
+		ConnectionDetails: cd,
+	}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
-	cr, ok := mg.(*iamv1alpha1.Token)
+	cr, ok := mg.(*iamv1beta1.Token)
 	if !ok {
 		return managed.ExternalDelete{}, errors.New(errNotToken)
 	}
-	uid, err := uuid.Parse(meta.GetExternalName(cr))
+	if id := cr.Status.AtProvider.PreviousTokenID; id != "" {
+		if err := e.deleteToken(ctx, id); err != nil {
+			return managed.ExternalDelete{}, errors.Wrap(err, "failed to delete previous token")
+		}
+	}
+	return managed.ExternalDelete{}, errors.Wrap(e.deleteToken(ctx, meta.GetExternalName(cr)), "failed to delete token")
+}
+
+// deleteToken deletes the token with the supplied ID if it still exists.
+func (e *external) deleteToken(ctx context.Context, id string) error {
+	uid, err := uuid.Parse(id)
 	if err != nil {
-		return managed.ExternalDelete{}, errors.Wrap(err, "cannot parse external name as UUID")
+		return errors.Wrap(err, "cannot parse token ID as UUID")
+	}
+	return resource.Ignore(uperrors.IsNotFound, e.tokens.Delete(ctx, uid))
+}
+
+// publishOutput writes the supplied credentials to the output Secret of the
+// supplied Token, if one is configured.
+func (e *external) publishOutput(ctx context.Context, cr *iamv1beta1.Token, creds token.Credentials) error {
+	o := cr.Spec.ForProvider.Output
+	if o == nil || o.SecretRef == nil {
+		return nil
+	}
+	ref := types.NamespacedName{Name: o.SecretRef.Name, Namespace: cr.GetNamespace()}
+	return e.secrets.Publish(ctx, cr, iamv1beta1.TokenGroupVersionKind, ref, o.TokenFormat, creds, e.profiles)
+}
+
+// outputFormat returns the format the credentials of the supplied Token are
+// written in.
+func outputFormat(cr *iamv1beta1.Token) iamv1alpha1common.TokenFormat {
+	if cr.Spec.ForProvider.Output == nil {
+		return iamv1alpha1common.TokenFormat{}
+	}
+	return cr.Spec.ForProvider.Output.TokenFormat
+}
+
+// credentialSources returns the Secrets the credentials of the supplied Token
+// are written to.
+func credentialSources(cr *iamv1beta1.Token) []types.NamespacedName {
+	var refs []types.NamespacedName
+	if ref := cr.GetWriteConnectionSecretToReference(); ref != nil {
+		refs = append(refs, types.NamespacedName{Name: ref.Name, Namespace: cr.GetNamespace()})
+	}
+	if o := cr.Spec.ForProvider.Output; o != nil && o.SecretRef != nil {
+		refs = append(refs, types.NamespacedName{Name: o.SecretRef.Name, Namespace: cr.GetNamespace()})
 	}
-	return managed.ExternalDelete{}, errors.Wrap(resource.Ignore(uperrors.IsNotFound, e.tokens.Delete(ctx, uid)), "failed to delete token")
+	return refs
 }
@@ -0,0 +1,371 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package export enumerates the resources of an Upbound organization and
+// renders them as observe-only managed resources that adopt them.
+package export
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/upbound/up-sdk-go"
+	"github.com/upbound/up-sdk-go/service/common"
+	"github.com/upbound/up-sdk-go/service/organizations"
+	"github.com/upbound/up-sdk-go/service/repositories"
+	"github.com/upbound/up-sdk-go/service/robots"
+
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	repov1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/repository/v1alpha1"
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
+	repov1beta1 "github.com/upbound/provider-upbound/apis/namespaced/repository/v1beta1"
+	"github.com/upbound/provider-upbound/internal/client/repositorypermission"
+	"github.com/upbound/provider-upbound/internal/client/robotteammembership"
+)
+
+// A Scope is the scope of the exported managed resources.
+type Scope string
+
+// Scopes of exported managed resources.
+const (
+	// ScopeCluster exports legacy cluster-scoped managed resources.
+	ScopeCluster Scope = "cluster"
+
+	// ScopeNamespaced exports namespaced managed resources.
+	ScopeNamespaced Scope = "namespaced"
+)
+
+// repositoryPageSize is the number of repositories requested per page.
+const repositoryPageSize = 100
+
+// Options configure the managed resources an Exporter renders.
+type Options struct {
+	// Organization whose resources are exported.
+	Organization string
+
+	// Scope of the exported managed resources.
+	Scope Scope
+
+	// Namespace of namespaced managed resources.
+	Namespace string
+
+	// ProviderConfigName is the name of the provider config the managed
+	// resources reference. The default provider config is used when it is
+	// empty.
+	ProviderConfigName string
+
+	// ProviderConfigKind is the kind of the provider config namespaced
+	// managed resources reference.
+	ProviderConfigKind string
+}
+
+// An Exporter renders the resources of an Upbound organization as
+// observe-only managed resources.
+type Exporter struct {
+	organizations *organizations.Client
+	robots        *robots.Client
+	repositories  *repositories.Client
+	permissions   *repositorypermission.Client
+
+	b *builder
+}
+
+// New returns an Exporter that uses the supplied Upbound client config.
+func New(cfg *up.Config, o Options) *Exporter {
+	return &Exporter{
+		organizations: organizations.NewClient(cfg),
+		robots:        robots.NewClient(cfg),
+		repositories:  repositories.NewClient(cfg),
+		permissions:   repositorypermission.NewClient(cfg),
+		b:             newBuilder(o),
+	}
+}
+
+// Export returns the teams, robots, robot team memberships, repositories,
+// repository permissions and tokens of the organization as managed resources.
+// Only the metadata of tokens is exported since their secrets cannot be read.
+func (e *Exporter) Export(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	org := e.b.o.Organization
+	orgID, err := e.organizations.GetOrgID(ctx, org)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get id of organization %s", org)
+	}
+
+	teams, err := e.organizations.ListTeams(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list teams")
+	}
+	teamNames := make(map[string]string, len(teams))
+	var objs []*unstructured.Unstructured
+	for _, t := range teams {
+		o := e.b.team(t)
+		teamNames[t.ID.String()] = o.GetName()
+		objs = append(objs, o)
+	}
+
+	rbs, err := e.organizations.ListRobots(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list robots")
+	}
+	for _, rb := range rbs {
+		o := e.b.robot(rb)
+		objs = append(objs, o)
+		for _, id := range rb.TeamIDs {
+			objs = append(objs, e.b.robotTeamMembership(rb, o.GetName(), id.String(), teamNames[id.String()]))
+		}
+		tokens, err := e.robots.ListTokens(ctx, rb.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot list tokens of robot %s", rb.Name)
+		}
+		for _, t := range tokens.DataSet {
+			objs = append(objs, e.b.token(t, rb.ID.String(), o.GetName()))
+		}
+	}
+
+	repos, err := e.listRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range repos {
+		objs = append(objs, e.b.repository(r))
+	}
+
+	for _, t := range teams {
+		grants, err := e.permissions.List(ctx, &repositorypermission.ListParameters{Organization: org, TeamID: t.ID.String()})
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot list repository permissions of team %s", t.Name)
+		}
+		for _, g := range grants {
+			objs = append(objs, e.b.permission(g, teamNames[t.ID.String()]))
+		}
+	}
+	return objs, nil
+}
+
+// listRepositories returns all repositories of the organization.
+func (e *Exporter) listRepositories(ctx context.Context) ([]repositories.Repository, error) {
+	var repos []repositories.Repository
+	for page := 1; ; page++ {
+		resp, err := e.repositories.List(ctx, e.b.o.Organization, common.WithSize(repositoryPageSize), common.WithPage(page))
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot list repositories")
+		}
+		repos = append(repos, resp.Repositories...)
+		if len(resp.Repositories) == 0 || len(repos) >= resp.Count {
+			return repos, nil
+		}
+	}
+}
+
+// Write writes the supplied managed resources to the supplied writer as a
+// stream of YAML documents.
+func Write(w io.Writer, objs []*unstructured.Unstructured) error {
+	for i, o := range objs {
+		b, err := yaml.Marshal(o.Object)
+		if err != nil {
+			return errors.Wrapf(err, "cannot marshal %s %s", o.GetKind(), o.GetName())
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return errors.Wrap(err, "cannot write separator")
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return errors.Wrapf(err, "cannot write %s %s", o.GetKind(), o.GetName())
+		}
+	}
+	return nil
+}
+
+// A builder renders Upbound resources as observe-only managed resources with
+// unique names per kind.
+type builder struct {
+	o     Options
+	names map[string]map[string]bool
+}
+
+func newBuilder(o Options) *builder {
+	return &builder{o: o, names: map[string]map[string]bool{}}
+}
+
+func (b *builder) team(t organizations.Team) *unstructured.Unstructured {
+	o := b.object(iamv1alpha1cluster.TeamGroupVersionKind, iamv1beta1.TeamGroupVersionKind, t.Name, t.ID.String())
+	b.setParameters(o, map[string]any{
+		"name":             t.Name,
+		"organizationName": b.o.Organization,
+	})
+	return o
+}
+
+func (b *builder) robot(rb organizations.Robot) *unstructured.Unstructured {
+	o := b.object(iamv1alpha1cluster.RobotGroupVersionKind, iamv1beta1.RobotGroupVersionKind, rb.Name, rb.ID.String())
+	p := map[string]any{
+		"name":        rb.Name,
+		"description": rb.Description,
+	}
+	// Namespaced robots reference their organization directly rather than
+	// through an owner.
+	if b.o.Scope == ScopeNamespaced {
+		p["organizationName"] = b.o.Organization
+	} else {
+		p["owner"] = map[string]any{"name": b.o.Organization}
+	}
+	b.setParameters(o, p)
+	return o
+}
+
+func (b *builder) robotTeamMembership(rb organizations.Robot, robotName, teamID, teamName string) *unstructured.Unstructured {
+	if teamName == "" {
+		teamName = teamID
+	}
+	o := b.object(iamv1alpha1cluster.RobotTeamMembershipGroupVersionKind, iamv1beta1.RobotTeamMembershipGroupVersionKind,
+		robotName+"-"+teamName, robotteammembership.ExternalName(rb.ID.String(), teamID))
+	b.setParameters(o, map[string]any{
+		"robotId": rb.ID.String(),
+		"teamId":  teamID,
+	})
+	return o
+}
+
+func (b *builder) token(t common.DataSet, robotID, robotName string) *unstructured.Unstructured {
+	name, _ := t.AttributeSet["name"].(string)
+	o := b.object(iamv1alpha1cluster.TokenGroupVersionKind, iamv1beta1.TokenGroupVersionKind, robotName+"-"+name, t.ID.String())
+	b.setParameters(o, map[string]any{
+		"name": name,
+		"owner": map[string]any{
+			"type": iamv1beta1.OwnerTypeRobots,
+			"id":   robotID,
+		},
+	})
+	return o
+}
+
+func (b *builder) repository(r repositories.Repository) *unstructured.Unstructured {
+	o := b.object(repov1alpha1cluster.RepositoryGroupVersionKind, repov1beta1.RepositoryGroupVersionKind, r.Name, r.Name)
+	p := map[string]any{
+		"name":             r.Name,
+		"organizationName": b.o.Organization,
+		"public":           r.Public,
+	}
+	switch {
+	case r.Publish == nil:
+	case b.o.Scope == ScopeNamespaced:
+		p["publishPolicy"] = string(*r.Publish)
+	default:
+		p["publish"] = *r.Publish == repositories.PublishPolicy(repov1beta1.PublishPolicyPublish)
+	}
+	b.setParameters(o, p)
+	return o
+}
+
+func (b *builder) permission(g repositorypermission.Grant, teamName string) *unstructured.Unstructured {
+	if teamName == "" {
+		teamName = g.TeamID
+	}
+	o := b.object(repov1alpha1cluster.PermissionGroupVersionKind, repov1beta1.PermissionGroupVersionKind,
+		g.RepositoryName+"-"+teamName, repositorypermission.ExternalName(b.o.Organization, g.RepositoryName, g.TeamID))
+	b.setParameters(o, map[string]any{
+		"organizationName": b.o.Organization,
+		"repository":       g.RepositoryName,
+		"teamId":           g.TeamID,
+		"permission":       g.Privilege,
+	})
+	return o
+}
+
+// object returns an observe-only managed resource of the kind of the
+// configured scope that adopts the external resource with the supplied
+// external name.
+func (b *builder) object(cluster, namespaced schema.GroupVersionKind, name, externalName string) *unstructured.Unstructured {
+	gvk := cluster
+	if b.o.Scope == ScopeNamespaced {
+		gvk = namespaced
+	}
+	o := &unstructured.Unstructured{Object: map[string]any{}}
+	o.SetGroupVersionKind(gvk)
+	o.SetName(b.uniqueName(gvk.Kind, name))
+	if b.o.Scope == ScopeNamespaced {
+		o.SetNamespace(b.o.Namespace)
+	}
+	meta.SetExternalName(o, externalName)
+
+	spec := map[string]any{
+		"managementPolicies": []any{"Observe"},
+	}
+	if pc := b.o.ProviderConfigName; pc != "" {
+		ref := map[string]any{"name": pc}
+		if b.o.Scope == ScopeNamespaced {
+			ref["kind"] = b.o.ProviderConfigKind
+		}
+		spec["providerConfigRef"] = ref
+	}
+	o.Object["spec"] = spec
+	return o
+}
+
+// setParameters sets the supplied parameters as spec.forProvider of the
+// supplied managed resource.
+func (b *builder) setParameters(o *unstructured.Unstructured, p map[string]any) {
+	o.Object["spec"].(map[string]any)["forProvider"] = p
+}
+
+// uniqueName returns a valid Kubernetes object name derived from the supplied
+// name that is unique among the objects of the supplied kind.
+func (b *builder) uniqueName(kind, name string) string {
+	if b.names[kind] == nil {
+		b.names[kind] = map[string]bool{}
+	}
+	base := Name(name)
+	n := base
+	for i := 2; b.names[kind][n]; i++ {
+		suffix := "-" + strconv.Itoa(i)
+		n = strings.TrimRight(truncate(base, maxNameLength-len(suffix)), "-.") + suffix
+	}
+	b.names[kind][n] = true
+	return n
+}
+
+// maxNameLength is the maximum length of a Kubernetes object name.
+const maxNameLength = 253
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// Name returns a valid Kubernetes object name derived from the supplied
+// Upbound resource name.
+func Name(name string) string {
+	n := invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	n = strings.Trim(truncate(n, maxNameLength), "-.")
+	if n == "" {
+		return "unnamed"
+	}
+	return n
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
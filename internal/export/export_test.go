@@ -0,0 +1,120 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/upbound/up-sdk-go/service/organizations"
+)
+
+func TestName(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		want string
+	}{
+		"Valid":        {name: "platform-team", want: "platform-team"},
+		"UpperCase":    {name: "Platform", want: "platform"},
+		"InvalidChars": {name: "ci bot_1", want: "ci-bot-1"},
+		"EdgeChars":    {name: "-ci-", want: "ci"},
+		"Empty":        {name: "!!", want: "unnamed"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Name(tc.name)); diff != "" {
+				t.Errorf("Name(%q): -want, +got:\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestTeam(t *testing.T) {
+	id := uuid.MustParse("5f2c1e0a-8f5b-4c8e-9d0e-1b2a3c4d5e6f")
+
+	cases := map[string]struct {
+		o     Options
+		teams []organizations.Team
+		want  []map[string]any
+	}{
+		"Cluster": {
+			o:     Options{Organization: "upbound", Scope: ScopeCluster, ProviderConfigName: "upbound"},
+			teams: []organizations.Team{{ID: id, Name: "Platform"}},
+			want: []map[string]any{{
+				"apiVersion": "iam.upbound.io/v1alpha1",
+				"kind":       "Team",
+				"metadata": map[string]any{
+					"name":        "platform",
+					"annotations": map[string]any{"crossplane.io/external-name": id.String()},
+				},
+				"spec": map[string]any{
+					"managementPolicies": []any{"Observe"},
+					"providerConfigRef":  map[string]any{"name": "upbound"},
+					"forProvider":        map[string]any{"name": "Platform", "organizationName": "upbound"},
+				},
+			}},
+		},
+		"NamespacedWithDuplicateNames": {
+			o:     Options{Organization: "upbound", Scope: ScopeNamespaced, Namespace: "upbound"},
+			teams: []organizations.Team{{ID: id, Name: "platform"}, {ID: id, Name: "Platform"}},
+			want: []map[string]any{
+				{
+					"apiVersion": "iam.m.upbound.io/v1beta1",
+					"kind":       "Team",
+					"metadata": map[string]any{
+						"name":        "platform",
+						"namespace":   "upbound",
+						"annotations": map[string]any{"crossplane.io/external-name": id.String()},
+					},
+					"spec": map[string]any{
+						"managementPolicies": []any{"Observe"},
+						"forProvider":        map[string]any{"name": "platform", "organizationName": "upbound"},
+					},
+				},
+				{
+					"apiVersion": "iam.m.upbound.io/v1beta1",
+					"kind":       "Team",
+					"metadata": map[string]any{
+						"name":        "platform-2",
+						"namespace":   "upbound",
+						"annotations": map[string]any{"crossplane.io/external-name": id.String()},
+					},
+					"spec": map[string]any{
+						"managementPolicies": []any{"Observe"},
+						"forProvider":        map[string]any{"name": "Platform", "organizationName": "upbound"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := newBuilder(tc.o)
+			got := make([]map[string]any, 0, len(tc.teams))
+			for _, team := range tc.teams {
+				got = append(got, b.team(team).Object)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("team(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
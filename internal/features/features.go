@@ -24,4 +24,9 @@ const (
 	// Management Policies. See the below design for more details.
 	// https://github.com/crossplane/crossplane/pull/3531
 	EnableAlphaManagementPolicies feature.Flag = "EnableAlphaManagementPolicies"
+
+	// RequireAdoptionAnnotation makes managed resources adopt existing
+	// external resources by name only when they are annotated with
+	// upbound.io/adopt-existing: "true". It is enabled by default.
+	RequireAdoptionAnnotation feature.Flag = "RequireAdoptionAnnotation"
 )
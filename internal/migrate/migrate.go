@@ -0,0 +1,483 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package migrate converts legacy cluster-scoped managed resources and
+// ProviderConfigs into their namespaced equivalents.
+package migrate
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/controlplane/v1alpha1"
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	pcv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/v1alpha1"
+	pcv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/v1alpha1"
+)
+
+const (
+	legacyGroupSuffix     = "upbound.io"
+	namespacedGroupSuffix = "m.upbound.io"
+
+	annotationLastApplied = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// A secretRef is a parameter of a legacy managed resource that references a
+// Secret by name and namespace. The namespaced equivalent references a Secret
+// in its own namespace instead.
+type secretRef struct {
+	// path of the reference below forProvider.
+	path []string
+
+	// owned Secrets are controlled by the managed resource referencing them,
+	// so they are handed over to its namespaced equivalent. Other Secrets are
+	// copied to the target namespace.
+	owned bool
+}
+
+// secretRefs are the parameters that reference Secrets, by legacy kind. They
+// are the only parameters whose schema differs between legacy and namespaced
+// managed resources.
+var secretRefs = map[schema.GroupKind][]secretRef{
+	cpv1alpha1cluster.ControlPlaneGroupVersionKind.GroupKind():  {{path: []string{"kubeconfigTokenSecretRef"}}},
+	iamv1alpha1cluster.RobotAccountGroupVersionKind.GroupKind(): {{path: []string{"output", "secretRef"}, owned: true}},
+	iamv1alpha1cluster.TokenGroupVersionKind.GroupKind():        {{path: []string{"output", "secretRef"}, owned: true}},
+}
+
+// Options configure how legacy resources are migrated.
+type Options struct {
+	// Namespace the namespaced managed resources are created in.
+	Namespace string
+
+	// ProviderConfigKind is the kind of the namespaced provider configs
+	// legacy ProviderConfigs are converted to, either ClusterProviderConfig
+	// or ProviderConfig.
+	ProviderConfigKind string
+
+	// DryRun only reports the resources that would be migrated.
+	DryRun bool
+}
+
+// A Migrator converts legacy cluster-scoped managed resources into their
+// namespaced equivalents and orphans the legacy resources, leaving the
+// external resources in Upbound untouched.
+type Migrator struct {
+	kube client.Client
+	log  logging.Logger
+	o    Options
+}
+
+// New returns a Migrator that uses the supplied Kubernetes client. The
+// client's scheme must know the legacy and namespaced provider configs.
+func New(kube client.Client, log logging.Logger, o Options) *Migrator {
+	if o.DryRun {
+		kube = client.NewDryRunClient(kube)
+	}
+	return &Migrator{kube: kube, log: log, o: o}
+}
+
+// Kinds returns the legacy managed resource kinds known to the supplied scheme
+// that have a namespaced equivalent known to it, sorted by group and kind.
+func Kinds(s *runtime.Scheme) []schema.GroupVersionKind {
+	return legacyKinds(s, true)
+}
+
+// Unsupported returns the legacy managed resource kinds known to the supplied
+// scheme that have no namespaced equivalent, sorted by group and kind.
+func Unsupported(s *runtime.Scheme) []schema.GroupVersionKind {
+	return legacyKinds(s, false)
+}
+
+func legacyKinds(s *runtime.Scheme, namespaced bool) []schema.GroupVersionKind {
+	var kinds []schema.GroupVersionKind
+	for gvk := range s.AllKnownTypes() {
+		if !strings.HasSuffix(gvk.Group, legacyGroupSuffix) {
+			continue
+		}
+		obj, err := s.New(gvk)
+		if err != nil {
+			continue
+		}
+		// Namespaced managed resources are not legacy managed resources.
+		if _, ok := obj.(resource.LegacyManaged); !ok {
+			continue
+		}
+		if s.Recognizes(NamespacedGroupVersionKind(gvk)) != namespaced {
+			continue
+		}
+		kinds = append(kinds, gvk)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if kinds[i].Group != kinds[j].Group {
+			return kinds[i].Group < kinds[j].Group
+		}
+		return kinds[i].Kind < kinds[j].Kind
+	})
+	return kinds
+}
+
+// NamespacedGroupVersionKind returns the kind of the namespaced equivalent of
+// the supplied legacy kind, e.g. iam.m.upbound.io for iam.upbound.io.
+func NamespacedGroupVersionKind(gvk schema.GroupVersionKind) schema.GroupVersionKind {
+	gvk.Group = strings.TrimSuffix(gvk.Group, legacyGroupSuffix) + namespacedGroupSuffix
+	return gvk
+}
+
+// Migrate converts all legacy ProviderConfigs and all legacy managed resources
+// of the supplied kinds. Legacy ProviderConfigs are left in place so that
+// legacy managed resources that are not migrated keep working.
+func (m *Migrator) Migrate(ctx context.Context, kinds []schema.GroupVersionKind) error {
+	if err := m.migrateProviderConfigs(ctx); err != nil {
+		return err
+	}
+	for _, gvk := range kinds {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := m.kube.List(ctx, l); err != nil {
+			if kmeta.IsNoMatchError(err) {
+				m.log.Debug("Skipping kind that is not installed", "kind", gvk.String())
+				continue
+			}
+			return errors.Wrapf(err, "cannot list %s", gvk.Kind)
+		}
+		for i := range l.Items {
+			if err := m.migrate(ctx, &l.Items[i]); err != nil {
+				return errors.Wrapf(err, "cannot migrate %s %s", gvk.Kind, l.Items[i].GetName())
+			}
+		}
+	}
+	return nil
+}
+
+// Skip reports the legacy managed resources of the supplied kinds, which have
+// no namespaced equivalent. They are left in place and keep being reconciled
+// by the legacy controllers.
+func (m *Migrator) Skip(ctx context.Context, kinds []schema.GroupVersionKind) error {
+	for _, gvk := range kinds {
+		l := &unstructured.UnstructuredList{}
+		l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := m.kube.List(ctx, l); err != nil {
+			if kmeta.IsNoMatchError(err) {
+				m.log.Debug("Skipping kind that is not installed", "kind", gvk.String())
+				continue
+			}
+			return errors.Wrapf(err, "cannot list %s", gvk.Kind)
+		}
+		names := make([]string, len(l.Items))
+		for i := range l.Items {
+			names[i] = l.Items[i].GetName()
+		}
+		m.log.Info("Skipping kind without a namespaced equivalent, its managed resources are left in place", "kind", gvk.String(), "count", len(names), "names", names)
+	}
+	return nil
+}
+
+func (m *Migrator) migrateProviderConfigs(ctx context.Context) error {
+	l := &pcv1alpha1cluster.ProviderConfigList{}
+	if err := m.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, "cannot list legacy provider configs")
+	}
+	for _, pc := range l.Items {
+		var npc client.Object = &pcv1alpha1.ClusterProviderConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: pc.GetName(), Labels: pc.GetLabels()},
+			Spec:       pcv1alpha1.ClusterProviderConfigSpec{ProviderConfigSpec: pc.Spec.ProviderConfigSpec},
+		}
+		if m.o.ProviderConfigKind == pcv1alpha1.ProviderConfigKind {
+			npc = &pcv1alpha1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: pc.GetName(), Namespace: m.o.Namespace, Labels: pc.GetLabels()},
+				Spec:       pcv1alpha1.ProviderConfigSpec{ProviderConfigSpec: pc.Spec.ProviderConfigSpec},
+			}
+		}
+		if err := m.kube.Create(ctx, npc); resource.Ignore(kerrors.IsAlreadyExists, err) != nil {
+			return errors.Wrapf(err, "cannot create %s %s", m.o.ProviderConfigKind, pc.GetName())
+		}
+		m.log.Info("Migrated provider config", "name", pc.GetName(), "kind", m.o.ProviderConfigKind)
+	}
+	return nil
+}
+
+// migrate creates the namespaced equivalent of the supplied legacy managed
+// resource, hands its connection secret over and orphans it.
+func (m *Migrator) migrate(ctx context.Context, legacy *unstructured.Unstructured) error {
+	// Composed resources are recreated by their composite resource, so
+	// they have to be migrated by migrating their composition instead.
+	if c := metav1.GetControllerOf(legacy); c != nil {
+		m.log.Info("Skipping managed resource controlled by another resource", "kind", legacy.GetKind(), "name", legacy.GetName(), "controller", c.Kind+"/"+c.Name)
+		return nil
+	}
+
+	nmr, err := Convert(legacy, m.o)
+	if err != nil {
+		return err
+	}
+	if err := m.migrateConnectionSecret(ctx, legacy); err != nil {
+		return err
+	}
+	if err := m.migrateReferencedSecrets(ctx, legacy, false, nil); err != nil {
+		return err
+	}
+
+	err = m.kube.Create(ctx, nmr)
+	if kerrors.IsAlreadyExists(err) {
+		// The resource was migrated by an earlier, interrupted run.
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(nmr.GroupVersionKind())
+		if err := m.kube.Get(ctx, types.NamespacedName{Namespace: nmr.GetNamespace(), Name: nmr.GetName()}, existing); err != nil {
+			return errors.Wrap(err, "cannot get namespaced managed resource")
+		}
+		if meta.GetExternalName(existing) != meta.GetExternalName(legacy) {
+			return errors.Errorf("namespaced managed resource %s/%s already exists with external name %q", nmr.GetNamespace(), nmr.GetName(), meta.GetExternalName(existing))
+		}
+		nmr, err = existing, nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot create namespaced managed resource")
+	}
+	// Owned Secrets can only be handed over once the namespaced managed
+	// resource exists.
+	if err := m.migrateReferencedSecrets(ctx, legacy, true, nmr); err != nil {
+		return err
+	}
+
+	if err := m.orphan(ctx, legacy); err != nil {
+		return err
+	}
+	m.log.Info("Migrated managed resource", "kind", legacy.GetKind(), "name", legacy.GetName(), "namespace", nmr.GetNamespace())
+	return nil
+}
+
+// migrateConnectionSecret makes the connection secret of the supplied legacy
+// managed resource available to its namespaced equivalent, either by copying
+// it to the target namespace or by releasing it from the legacy resource so
+// that it is not garbage collected.
+func (m *Migrator) migrateConnectionSecret(ctx context.Context, legacy *unstructured.Unstructured) error {
+	ref := &xpv1.SecretReference{}
+	ref.Name, _, _ = unstructured.NestedString(legacy.Object, "spec", "writeConnectionSecretToRef", "name")
+	ref.Namespace, _, _ = unstructured.NestedString(legacy.Object, "spec", "writeConnectionSecretToRef", "namespace")
+	if ref.Name == "" {
+		return nil
+	}
+
+	s := &corev1.Secret{}
+	err := m.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot get connection secret")
+	}
+
+	if ref.Namespace == m.o.Namespace {
+		s.SetOwnerReferences(release(s.GetOwnerReferences(), legacy.GetUID()))
+		return errors.Wrap(m.kube.Update(ctx, s), "cannot release connection secret")
+	}
+	return errors.Wrap(m.copySecret(ctx, s, nil), "cannot copy connection secret")
+}
+
+// migrateReferencedSecrets makes the Secrets referenced by the parameters of
+// the supplied legacy managed resource available to its namespaced
+// equivalent. Owned Secrets are handed over to the supplied namespaced
+// managed resource, other Secrets are copied to the target namespace.
+func (m *Migrator) migrateReferencedSecrets(ctx context.Context, legacy *unstructured.Unstructured, owned bool, nmr *unstructured.Unstructured) error {
+	for _, sr := range secretRefs[legacy.GroupVersionKind().GroupKind()] {
+		if sr.owned != owned {
+			continue
+		}
+		path := append([]string{"spec", "forProvider"}, sr.path...)
+		ref := types.NamespacedName{}
+		ref.Name, _, _ = unstructured.NestedString(legacy.Object, append(path, "name")...)
+		ref.Namespace, _, _ = unstructured.NestedString(legacy.Object, append(path, "namespace")...)
+		if ref.Name == "" {
+			continue
+		}
+
+		s := &corev1.Secret{}
+		err := m.kube.Get(ctx, ref, s)
+		if kerrors.IsNotFound(err) {
+			m.log.Info("Skipping referenced secret that does not exist", "kind", legacy.GetKind(), "name", legacy.GetName(), "secret", ref.String())
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "cannot get secret %s", ref)
+		}
+
+		if !owned {
+			if ref.Namespace == m.o.Namespace {
+				continue
+			}
+			if err := m.copySecret(ctx, s, nil); err != nil {
+				return errors.Wrapf(err, "cannot copy secret %s", ref)
+			}
+			m.log.Info("Copied referenced secret", "kind", legacy.GetKind(), "name", legacy.GetName(), "secret", ref.String(), "namespace", m.o.Namespace)
+			continue
+		}
+
+		owner := meta.AsController(meta.TypedReferenceTo(nmr, nmr.GroupVersionKind()))
+		if ref.Namespace == m.o.Namespace {
+			s.SetOwnerReferences(append(release(s.GetOwnerReferences(), legacy.GetUID()), owner))
+			if err := m.kube.Update(ctx, s); err != nil {
+				return errors.Wrapf(err, "cannot hand over secret %s", ref)
+			}
+			m.log.Info("Handed over owned secret", "kind", legacy.GetKind(), "name", legacy.GetName(), "secret", ref.String())
+			continue
+		}
+		if err := m.copySecret(ctx, s, []metav1.OwnerReference{owner}); err != nil {
+			return errors.Wrapf(err, "cannot copy secret %s", ref)
+		}
+		// The legacy Secret is garbage collected along with the legacy
+		// managed resource, so consumers have to use the copy.
+		m.log.Info("Moved owned secret to the target namespace, the legacy secret is deleted with the legacy managed resource", "kind", legacy.GetKind(), "name", legacy.GetName(), "secret", ref.String(), "namespace", m.o.Namespace)
+	}
+	return nil
+}
+
+// copySecret copies the supplied Secret to the target namespace, unless a
+// Secret of the same name exists there.
+func (m *Migrator) copySecret(ctx context.Context, s *corev1.Secret, owners []metav1.OwnerReference) error {
+	c := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            s.GetName(),
+			Namespace:       m.o.Namespace,
+			Labels:          s.GetLabels(),
+			Annotations:     s.GetAnnotations(),
+			OwnerReferences: owners,
+		},
+		Type: s.Type,
+		Data: s.Data,
+	}
+	return resource.Ignore(kerrors.IsAlreadyExists, m.kube.Create(ctx, c))
+}
+
+// release returns the supplied owner references without those to the owner
+// with the supplied UID.
+func release(refs []metav1.OwnerReference, uid types.UID) []metav1.OwnerReference {
+	kept := make([]metav1.OwnerReference, 0, len(refs))
+	for _, r := range refs {
+		if r.UID != uid {
+			kept = append(kept, r)
+		}
+	}
+	return kept
+}
+
+// orphan pauses the supplied legacy managed resource, removes its finalizer
+// and deletes it, leaving its external resource in place.
+func (m *Migrator) orphan(ctx context.Context, legacy *unstructured.Unstructured) error {
+	orig := legacy.DeepCopy()
+	meta.AddAnnotations(legacy, map[string]string{meta.AnnotationKeyReconciliationPaused: "true"})
+	meta.RemoveFinalizer(legacy, managed.FinalizerName)
+	if err := unstructured.SetNestedField(legacy.Object, string(xpv1.DeletionOrphan), "spec", "deletionPolicy"); err != nil {
+		return errors.Wrap(err, "cannot set deletion policy")
+	}
+	if err := m.kube.Patch(ctx, legacy, client.MergeFrom(orig)); err != nil {
+		return errors.Wrap(err, "cannot orphan legacy managed resource")
+	}
+	return errors.Wrap(resource.IgnoreNotFound(m.kube.Delete(ctx, legacy)), "cannot delete legacy managed resource")
+}
+
+// Convert returns the namespaced equivalent of the supplied legacy managed
+// resource. Its parameters are copied verbatim since legacy and namespaced
+// references share their fields; references resolve to namespaced managed
+// resources of the same names in the target namespace. References to Secrets
+// lose their namespace since namespaced managed resources reference Secrets
+// in their own namespace.
+func Convert(legacy *unstructured.Unstructured, o Options) (*unstructured.Unstructured, error) {
+	spec, _, err := unstructured.NestedMap(legacy.Object, "spec")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get spec")
+	}
+
+	nspec := map[string]any{}
+	for _, k := range []string{"forProvider", "initProvider"} {
+		if v, ok := spec[k]; ok {
+			nspec[k] = v
+		}
+		for _, sr := range secretRefs[legacy.GroupVersionKind().GroupKind()] {
+			unstructured.RemoveNestedField(nspec, append(append([]string{k}, sr.path...), "namespace")...)
+		}
+	}
+	pc, _, _ := unstructured.NestedString(spec, "providerConfigRef", "name")
+	if pc == "" {
+		pc = "default"
+	}
+	nspec["providerConfigRef"] = map[string]any{"name": pc, "kind": o.ProviderConfigKind}
+	if s, _, _ := unstructured.NestedString(spec, "writeConnectionSecretToRef", "name"); s != "" {
+		nspec["writeConnectionSecretToRef"] = map[string]any{"name": s}
+	}
+	if p := managementPolicies(spec); p != nil {
+		nspec["managementPolicies"] = p
+	}
+
+	nmr := &unstructured.Unstructured{Object: map[string]any{"spec": nspec}}
+	nmr.SetGroupVersionKind(NamespacedGroupVersionKind(legacy.GroupVersionKind()))
+	nmr.SetName(legacy.GetName())
+	nmr.SetNamespace(o.Namespace)
+	nmr.SetLabels(legacy.GetLabels())
+	annotations := legacy.GetAnnotations()
+	delete(annotations, annotationLastApplied)
+	nmr.SetAnnotations(annotations)
+	return nmr, nil
+}
+
+// managementPolicies returns the management policies of the namespaced
+// equivalent of a legacy managed resource with the supplied spec. Namespaced
+// managed resources have no deletion policy, so an Orphan deletion policy is
+// expressed by omitting the Delete policy.
+func managementPolicies(spec map[string]any) []any {
+	policies, _, _ := unstructured.NestedStringSlice(spec, "managementPolicies")
+	if dp, _, _ := unstructured.NestedString(spec, "deletionPolicy"); dp == string(xpv1.DeletionOrphan) {
+		if len(policies) == 0 || (len(policies) == 1 && policies[0] == string(xpv1.ManagementActionAll)) {
+			policies = []string{
+				string(xpv1.ManagementActionObserve),
+				string(xpv1.ManagementActionCreate),
+				string(xpv1.ManagementActionUpdate),
+				string(xpv1.ManagementActionLateInitialize),
+			}
+		}
+		kept := policies[:0:0]
+		for _, p := range policies {
+			if p != string(xpv1.ManagementActionDelete) {
+				kept = append(kept, p)
+			}
+		}
+		policies = kept
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	out := make([]any, len(policies))
+	for i, p := range policies {
+		out[i] = p
+	}
+	return out
+}
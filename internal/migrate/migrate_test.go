@@ -0,0 +1,345 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiscluster "github.com/upbound/provider-upbound/apis/cluster"
+	iamv1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/iam/v1alpha1"
+	repov1alpha1cluster "github.com/upbound/provider-upbound/apis/cluster/repository/v1alpha1"
+	apis "github.com/upbound/provider-upbound/apis/namespaced"
+)
+
+func TestKinds(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apiscluster.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	kinds := map[string]bool{}
+	for _, gvk := range Kinds(s) {
+		kinds[gvk.GroupKind().String()] = true
+	}
+	for _, want := range []string{
+		iamv1alpha1cluster.TeamGroupKind,
+		iamv1alpha1cluster.RobotGroupKind,
+		iamv1alpha1cluster.TokenGroupKind,
+		iamv1alpha1cluster.RobotTeamMembershipGroupKind,
+		repov1alpha1cluster.RepositoryGroupKind,
+		repov1alpha1cluster.PermissionGroupKind,
+	} {
+		if !kinds[want] {
+			t.Errorf("Kinds(...): missing %s", want)
+		}
+	}
+	if kinds[iamv1alpha1cluster.PullSecretDistributionGroupKind] {
+		t.Errorf("Kinds(...): %s has no namespaced equivalent", iamv1alpha1cluster.PullSecretDistributionGroupKind)
+	}
+
+	unsupported := map[string]bool{}
+	for _, gvk := range Unsupported(s) {
+		unsupported[gvk.GroupKind().String()] = true
+	}
+	if diff := cmp.Diff(map[string]bool{iamv1alpha1cluster.PullSecretDistributionGroupKind: true}, unsupported); diff != "" {
+		t.Errorf("Unsupported(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	cases := map[string]struct {
+		legacy map[string]any
+		o      Options
+		want   map[string]any
+	}{
+		"Token": {
+			legacy: map[string]any{
+				"apiVersion": "iam.upbound.io/v1alpha1",
+				"kind":       "Token",
+				"metadata": map[string]any{
+					"name": "ci",
+					"annotations": map[string]any{
+						"crossplane.io/external-name":                      "5f2c1e0a-8f5b-4c8e-9d0e-1b2a3c4d5e6f",
+						"kubectl.kubernetes.io/last-applied-configuration": "{}",
+					},
+					"finalizers": []any{"finalizer.managedresource.crossplane.io"},
+				},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"name":  "ci",
+						"owner": map[string]any{"type": "robots", "idRef": map[string]any{"name": "ci"}},
+					},
+					"providerConfigRef":          map[string]any{"name": "upbound"},
+					"writeConnectionSecretToRef": map[string]any{"name": "ci-token", "namespace": "crossplane-system"},
+					"deletionPolicy":             "Delete",
+				},
+			},
+			o: Options{Namespace: "platform", ProviderConfigKind: "ClusterProviderConfig"},
+			want: map[string]any{
+				"apiVersion": "iam.m.upbound.io/v1alpha1",
+				"kind":       "Token",
+				"metadata": map[string]any{
+					"name":      "ci",
+					"namespace": "platform",
+					"annotations": map[string]any{
+						"crossplane.io/external-name": "5f2c1e0a-8f5b-4c8e-9d0e-1b2a3c4d5e6f",
+					},
+				},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"name":  "ci",
+						"owner": map[string]any{"type": "robots", "idRef": map[string]any{"name": "ci"}},
+					},
+					"providerConfigRef":          map[string]any{"name": "upbound", "kind": "ClusterProviderConfig"},
+					"writeConnectionSecretToRef": map[string]any{"name": "ci-token"},
+				},
+			},
+		},
+		"TokenOutputSecret": {
+			legacy: map[string]any{
+				"apiVersion": "iam.upbound.io/v1alpha1",
+				"kind":       "Token",
+				"metadata":   map[string]any{"name": "pull"},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"name": "pull",
+						"output": map[string]any{
+							"format":    "DockerConfigJSON",
+							"secretRef": map[string]any{"name": "pull", "namespace": "crossplane-system"},
+						},
+					},
+				},
+			},
+			o: Options{Namespace: "platform", ProviderConfigKind: "ClusterProviderConfig"},
+			want: map[string]any{
+				"apiVersion": "iam.m.upbound.io/v1alpha1",
+				"kind":       "Token",
+				"metadata":   map[string]any{"name": "pull", "namespace": "platform"},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"name": "pull",
+						"output": map[string]any{
+							"format":    "DockerConfigJSON",
+							"secretRef": map[string]any{"name": "pull"},
+						},
+					},
+					"providerConfigRef": map[string]any{"name": "default", "kind": "ClusterProviderConfig"},
+				},
+			},
+		},
+		"ControlPlaneKubeconfigTokenSecret": {
+			legacy: map[string]any{
+				"apiVersion": "controlplane.upbound.io/v1alpha1",
+				"kind":       "ControlPlane",
+				"metadata":   map[string]any{"name": "dev"},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"name":                     "dev",
+						"kubeconfigTokenSecretRef": map[string]any{"name": "dev-token", "namespace": "crossplane-system", "key": "token"},
+					},
+				},
+			},
+			o: Options{Namespace: "platform", ProviderConfigKind: "ClusterProviderConfig"},
+			want: map[string]any{
+				"apiVersion": "controlplane.m.upbound.io/v1alpha1",
+				"kind":       "ControlPlane",
+				"metadata":   map[string]any{"name": "dev", "namespace": "platform"},
+				"spec": map[string]any{
+					"forProvider": map[string]any{
+						"name":                     "dev",
+						"kubeconfigTokenSecretRef": map[string]any{"name": "dev-token", "key": "token"},
+					},
+					"providerConfigRef": map[string]any{"name": "default", "kind": "ClusterProviderConfig"},
+				},
+			},
+		},
+		"OrphanDefaultPolicies": {
+			legacy: map[string]any{
+				"apiVersion": "repository.upbound.io/v1alpha1",
+				"kind":       "Repository",
+				"metadata":   map[string]any{"name": "configs"},
+				"spec": map[string]any{
+					"forProvider":        map[string]any{"name": "configs"},
+					"managementPolicies": []any{"*"},
+					"deletionPolicy":     "Orphan",
+				},
+			},
+			o: Options{Namespace: "platform", ProviderConfigKind: "ProviderConfig"},
+			want: map[string]any{
+				"apiVersion": "repository.m.upbound.io/v1alpha1",
+				"kind":       "Repository",
+				"metadata":   map[string]any{"name": "configs", "namespace": "platform"},
+				"spec": map[string]any{
+					"forProvider":        map[string]any{"name": "configs"},
+					"providerConfigRef":  map[string]any{"name": "default", "kind": "ProviderConfig"},
+					"managementPolicies": []any{"Observe", "Create", "Update", "LateInitialize"},
+				},
+			},
+		},
+		"OrphanCustomPolicies": {
+			legacy: map[string]any{
+				"apiVersion": "iam.upbound.io/v1alpha1",
+				"kind":       "Team",
+				"metadata":   map[string]any{"name": "platform"},
+				"spec": map[string]any{
+					"forProvider":        map[string]any{"name": "platform"},
+					"managementPolicies": []any{"Observe", "Delete"},
+					"deletionPolicy":     "Orphan",
+				},
+			},
+			o: Options{Namespace: "platform", ProviderConfigKind: "ClusterProviderConfig"},
+			want: map[string]any{
+				"apiVersion": "iam.m.upbound.io/v1alpha1",
+				"kind":       "Team",
+				"metadata":   map[string]any{"name": "platform", "namespace": "platform"},
+				"spec": map[string]any{
+					"forProvider":        map[string]any{"name": "platform"},
+					"providerConfigRef":  map[string]any{"name": "default", "kind": "ClusterProviderConfig"},
+					"managementPolicies": []any{"Observe"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Convert(&unstructured.Unstructured{Object: tc.legacy}, tc.o)
+			if err != nil {
+				t.Fatalf("Convert(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got.Object); diff != "" {
+				t.Errorf("Convert(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMigrateSecrets(t *testing.T) {
+	const legacyUID = types.UID("legacy")
+
+	// token returns a legacy Token whose output Secret is in the supplied
+	// namespace.
+	token := func(namespace string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "iam.upbound.io/v1alpha1",
+			"kind":       "Token",
+			"metadata":   map[string]any{"name": "pull", "uid": string(legacyUID)},
+			"spec": map[string]any{
+				"forProvider": map[string]any{
+					"name":  "pull",
+					"owner": map[string]any{"type": "robots", "id": "5f2c1e0a-8f5b-4c8e-9d0e-1b2a3c4d5e6f"},
+					"output": map[string]any{
+						"format":    "DockerConfigJSON",
+						"secretRef": map[string]any{"name": "pull", "namespace": namespace},
+					},
+				},
+			},
+		}}
+	}
+	secret := func(namespace string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pull",
+				Namespace: namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "iam.upbound.io/v1alpha1", Kind: "Token", Name: "pull", UID: legacyUID, Controller: ptr.To(true)},
+					{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "other"},
+				},
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte("{}")},
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		legacy *unstructured.Unstructured
+		secret *corev1.Secret
+		owners []metav1.OwnerReference
+	}{
+		"HandOver": {
+			reason: "An output Secret in the target namespace is handed over to the namespaced Token.",
+			legacy: token("platform"),
+			secret: secret("platform"),
+			owners: []metav1.OwnerReference{
+				{APIVersion: "v1", Kind: "ConfigMap", Name: "other", UID: "other"},
+				{APIVersion: "iam.m.upbound.io/v1alpha1", Kind: "Token", Name: "pull", Controller: ptr.To(true), BlockOwnerDeletion: ptr.To(true)},
+			},
+		},
+		"Move": {
+			reason: "An output Secret in another namespace is copied to the target namespace and controlled by the namespaced Token.",
+			legacy: token("crossplane-system"),
+			secret: secret("crossplane-system"),
+			owners: []metav1.OwnerReference{
+				{APIVersion: "iam.m.upbound.io/v1alpha1", Kind: "Token", Name: "pull", Controller: ptr.To(true), BlockOwnerDeletion: ptr.To(true)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, apiscluster.AddToScheme, apis.AddToScheme} {
+				if err := add(s); err != nil {
+					t.Fatal(err)
+				}
+			}
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.legacy, tc.secret).Build()
+			m := New(kube, logging.NewNopLogger(), Options{Namespace: "platform", ProviderConfigKind: "ClusterProviderConfig"})
+
+			if err := m.migrate(context.Background(), tc.legacy); err != nil {
+				t.Fatalf("\n%s\nmigrate(...): %v", tc.reason, err)
+			}
+
+			got := &corev1.Secret{}
+			if err := kube.Get(context.Background(), types.NamespacedName{Namespace: "platform", Name: "pull"}, got); err != nil {
+				t.Fatalf("\n%s\nmigrate(...): cannot get output secret: %v", tc.reason, err)
+			}
+			nmr := &unstructured.Unstructured{}
+			nmr.SetGroupVersionKind(NamespacedGroupVersionKind(tc.legacy.GroupVersionKind()))
+			if err := kube.Get(context.Background(), types.NamespacedName{Namespace: "platform", Name: "pull"}, nmr); err != nil {
+				t.Fatalf("\n%s\nmigrate(...): cannot get namespaced Token: %v", tc.reason, err)
+			}
+			for i := range tc.owners {
+				if tc.owners[i].Kind == "Token" {
+					tc.owners[i].UID = nmr.GetUID()
+				}
+			}
+			if diff := cmp.Diff(tc.owners, got.GetOwnerReferences()); diff != "" {
+				t.Errorf("\n%s\nmigrate(...): -want owners, +got owners:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.secret.Data, got.Data); diff != "" {
+				t.Errorf("\n%s\nmigrate(...): -want data, +got data:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storageversion migrates the stored objects of custom resources to
+// their storage version once a new API version becomes the storage version.
+package storageversion
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/resource"
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// A Migrator rewrites all objects of a set of custom resources so that the
+// API server stores them in their storage version, and then drops the other
+// versions from the stored versions of their CustomResourceDefinitions. Only
+// then can the other versions be removed from the CRDs.
+type Migrator struct {
+	kube   client.Client
+	reader client.Reader
+	log    logging.Logger
+	gks    []schema.GroupKind
+}
+
+// New returns a Migrator of the custom resources of the supplied kinds. The
+// reader is used to list objects, so that no informers are started for them.
+func New(kube client.Client, reader client.Reader, log logging.Logger, gks ...schema.GroupKind) *Migrator {
+	return &Migrator{kube: kube, reader: reader, log: log, gks: gks}
+}
+
+// Start migrates the custom resources. Failures are logged rather than
+// returned because they must not stop the manager; the migration is retried
+// when the provider restarts.
+func (m *Migrator) Start(ctx context.Context) error {
+	for _, gk := range m.gks {
+		if err := m.migrate(ctx, gk); err != nil {
+			m.log.Info("Cannot migrate storage version", "kind", gk.String(), "error", err)
+		}
+	}
+	return nil
+}
+
+// NeedLeaderElection returns true so that only one replica of the provider
+// migrates objects.
+func (m *Migrator) NeedLeaderElection() bool {
+	return true
+}
+
+func (m *Migrator) migrate(ctx context.Context, gk schema.GroupKind) error {
+	mapping, err := m.kube.RESTMapper().RESTMapping(gk)
+	if kmeta.IsNoMatchError(err) {
+		// The CRD is not installed, so there is nothing to migrate.
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot get REST mapping")
+	}
+	crd := &extv1.CustomResourceDefinition{}
+	if err := m.reader.Get(ctx, types.NamespacedName{Name: mapping.Resource.Resource + "." + gk.Group}, crd); err != nil {
+		return errors.Wrap(err, "cannot get CustomResourceDefinition")
+	}
+	storage := StorageVersion(crd)
+	if storage == "" || slices.Equal(crd.Status.StoredVersions, []string{storage}) {
+		return nil
+	}
+
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(gk.WithVersion(storage).GroupVersion().WithKind(gk.Kind + "List"))
+	if err := m.reader.List(ctx, l); err != nil {
+		return errors.Wrap(err, "cannot list objects")
+	}
+	for i := range l.Items {
+		// An update that changes nothing still makes the API server write
+		// the object, and it writes it in the storage version.
+		err := m.kube.Update(ctx, &l.Items[i])
+		if kerrors.IsNotFound(err) || kerrors.IsConflict(err) {
+			// Deleted or updated since it was listed, and thus already
+			// stored in the storage version.
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "cannot update %s", client.ObjectKeyFromObject(&l.Items[i]))
+		}
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := m.reader.Get(ctx, client.ObjectKeyFromObject(crd), crd); err != nil {
+			return err
+		}
+		crd.Status.StoredVersions = []string{storage}
+		return m.kube.Status().Update(ctx, crd)
+	})
+	if kerrors.IsForbidden(err) {
+		// Providers are usually not allowed to update CRDs. The objects are
+		// migrated nonetheless, so the stored versions can be updated by
+		// whoever installs the CRDs.
+		m.log.Info("Cannot update stored versions of CustomResourceDefinition, all objects are migrated and it can be updated manually", "name", crd.GetName(), "storageVersion", storage)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "cannot update stored versions of CustomResourceDefinition")
+	}
+	m.log.Debug("Migrated storage version", "name", crd.GetName(), "storageVersion", storage, "objects", len(l.Items))
+	return nil
+}
+
+// Kinds returns the kinds of the managed resources of the supplied API
+// versions, sorted by group and kind.
+func Kinds(s *runtime.Scheme, gvs ...schema.GroupVersion) []schema.GroupKind {
+	var gks []schema.GroupKind
+	for _, gv := range gvs {
+		for kind := range s.KnownTypes(gv) {
+			o, err := s.New(gv.WithKind(kind))
+			if err != nil {
+				continue
+			}
+			if _, ok := o.(resource.Managed); ok {
+				gks = append(gks, gv.WithKind(kind).GroupKind())
+			}
+		}
+	}
+	sort.Slice(gks, func(i, j int) bool {
+		if gks[i].Group != gks[j].Group {
+			return gks[i].Group < gks[j].Group
+		}
+		return gks[i].Kind < gks[j].Kind
+	})
+	return gks
+}
+
+// StorageVersion returns the storage version of the supplied CRD, or an empty
+// string if it has none.
+func StorageVersion(crd *extv1.CustomResourceDefinition) string {
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			return v.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storageversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	team   = schema.GroupKind{Group: "iam.m.upbound.io", Kind: "Team"}
+	teamGR = schema.GroupResource{Group: team.Group, Resource: "teams"}
+)
+
+// mapperClient is a MockClient with a REST mapper.
+type mapperClient struct {
+	*test.MockClient
+	mapper kmeta.RESTMapper
+}
+
+func (c *mapperClient) RESTMapper() kmeta.RESTMapper {
+	return c.mapper
+}
+
+func TestMigrate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		installed bool
+		stored    []string
+		update    func(name string) error
+		status    error
+	}
+	type want struct {
+		err     error
+		updated []string
+		stored  []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotInstalled": {
+			reason: "Kinds whose CRD is not installed should be skipped.",
+			args:   args{},
+		},
+		"AlreadyMigrated": {
+			reason: "Nothing should be rewritten when only the storage version is stored.",
+			args: args{
+				installed: true,
+				stored:    []string{"v1beta1"},
+			},
+		},
+		"Migrated": {
+			reason: "All objects should be rewritten before the stored versions are updated.",
+			args: args{
+				installed: true,
+				stored:    []string{"v1alpha1", "v1beta1"},
+			},
+			want: want{
+				updated: []string{"a", "b"},
+				stored:  []string{"v1beta1"},
+			},
+		},
+		"ConflictSkipped": {
+			reason: "Objects updated since they were listed are already stored in the storage version.",
+			args: args{
+				installed: true,
+				stored:    []string{"v1alpha1", "v1beta1"},
+				update: func(name string) error {
+					if name == "a" {
+						return kerrors.NewConflict(teamGR, name, errBoom)
+					}
+					return nil
+				},
+			},
+			want: want{
+				updated: []string{"a", "b"},
+				stored:  []string{"v1beta1"},
+			},
+		},
+		"UpdateFailed": {
+			reason: "Objects that cannot be rewritten should stop the migration before the stored versions are updated.",
+			args: args{
+				installed: true,
+				stored:    []string{"v1alpha1", "v1beta1"},
+				update: func(_ string) error {
+					return errBoom
+				},
+			},
+			want: want{
+				err:     errors.Wrap(errBoom, "cannot update default/a"),
+				updated: []string{"a"},
+			},
+		},
+		"StatusForbidden": {
+			reason: "Not being allowed to update the stored versions should not be an error.",
+			args: args{
+				installed: true,
+				stored:    []string{"v1alpha1", "v1beta1"},
+				status:    kerrors.NewForbidden(schema.GroupResource{Group: extv1.GroupName, Resource: "customresourcedefinitions"}, "teams.iam.m.upbound.io", errBoom),
+			},
+			want: want{
+				updated: []string{"a", "b"},
+			},
+		},
+		"StatusFailed": {
+			reason: "Other errors updating the stored versions should be returned.",
+			args: args{
+				installed: true,
+				stored:    []string{"v1alpha1", "v1beta1"},
+				status:    errBoom,
+			},
+			want: want{
+				err:     errors.Wrap(errBoom, "cannot update stored versions of CustomResourceDefinition"),
+				updated: []string{"a", "b"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mapper := kmeta.NewDefaultRESTMapper([]schema.GroupVersion{{Group: team.Group, Version: "v1beta1"}})
+			if tc.args.installed {
+				mapper.Add(team.WithVersion("v1beta1"), kmeta.RESTScopeNamespace)
+			}
+
+			var updated, stored []string
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					if key.Name != "teams.iam.m.upbound.io" {
+						return errors.Errorf("unexpected get of %s", key)
+					}
+					crd := obj.(*extv1.CustomResourceDefinition)
+					crd.SetName(key.Name)
+					crd.Spec.Versions = []extv1.CustomResourceDefinitionVersion{{Name: "v1alpha1"}, {Name: "v1beta1", Storage: true}}
+					crd.Status.StoredVersions = tc.args.stored
+					return nil
+				},
+				MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+					l := obj.(*unstructured.UnstructuredList)
+					for _, n := range []string{"a", "b"} {
+						u := unstructured.Unstructured{}
+						u.SetGroupVersionKind(team.WithVersion("v1beta1"))
+						u.SetNamespace("default")
+						u.SetName(n)
+						l.Items = append(l.Items, u)
+					}
+					return nil
+				},
+				MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+					updated = append(updated, obj.GetName())
+					if tc.args.update == nil {
+						return nil
+					}
+					return tc.args.update(obj.GetName())
+				},
+				MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					if tc.args.status != nil {
+						return tc.args.status
+					}
+					stored = obj.(*extv1.CustomResourceDefinition).Status.StoredVersions
+					return nil
+				},
+			}
+
+			m := New(&mapperClient{MockClient: kube, mapper: mapper}, kube, logging.NewNopLogger(), team)
+			err := m.migrate(context.Background(), team)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nmigrate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("\n%s\nmigrate(...): -want updated objects, +got updated objects:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.stored, stored); diff != "" {
+				t.Errorf("\n%s\nmigrate(...): -want stored versions, +got stored versions:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook validates Upbound managed resources on admission so that
+// invalid specs are rejected before they reach the Upbound API.
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/v2/pkg/fieldpath"
+	admissionv1 "k8s.io/api/admission/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	iamv1beta1 "github.com/upbound/provider-upbound/apis/namespaced/iam/v1beta1"
+	pcv1alpha1 "github.com/upbound/provider-upbound/apis/namespaced/v1alpha1"
+)
+
+// ValidatePath is the path the validating webhook is served at.
+const ValidatePath = "/validate-upbound-io"
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-upbound-io,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1,name=managed.upbound.io,groups=controlplane.upbound.io;iam.upbound.io;repository.upbound.io;controlplane.m.upbound.io;iam.m.upbound.io;repository.m.upbound.io,resources=*,versions=v1alpha1;v1beta1
+
+// ConvertPath is the path the conversion webhook is served at.
+const ConvertPath = "/convert"
+
+// Setup registers the validating and conversion webhooks of Upbound managed
+// resources with the webhook server of the supplied manager.
+func Setup(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(ValidatePath, &admission.Webhook{Handler: &validator{}})
+	mgr.GetWebhookServer().Register(ConvertPath, conversion.NewWebhookHandler(mgr.GetScheme()))
+	return nil
+}
+
+// A validator validates Upbound managed resources of both scopes.
+type validator struct{}
+
+// Handle validates the managed resource of the supplied admission request.
+func (v *validator) Handle(_ context.Context, req admission.Request) admission.Response {
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(req.Object.Raw); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	var old *unstructured.Unstructured
+	if req.Operation == admissionv1.Update {
+		old = &unstructured.Unstructured{}
+		if err := old.UnmarshalJSON(req.OldObject.Raw); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	errs := Validate(obj, old)
+	if len(errs) == 0 {
+		return admission.Allowed("")
+	}
+	gk := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+	st := kerrors.NewInvalid(gk, obj.GetName(), errs)
+	return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result:  &st.ErrStatus,
+	}}
+}
+
+// Validate returns the errors of the supplied managed resource. The previous
+// version of the managed resource is supplied for updates and nil for
+// creates. Updates that leave the spec unchanged, e.g. of the status or of
+// finalizers, are always valid so that resources created before the webhook
+// was installed can still be reconciled and deleted.
+func Validate(obj, old *unstructured.Unstructured) field.ErrorList {
+	r, ok := rules[obj.GetKind()]
+	if !ok {
+		return nil
+	}
+	p := fieldpath.Pave(obj.Object)
+	var op *fieldpath.Paved
+	if old != nil {
+		op = fieldpath.Pave(old.Object)
+		spec, _ := p.GetValue("spec")
+		ospec, _ := op.GetValue("spec")
+		if reflect.DeepEqual(spec, ospec) {
+			return nil
+		}
+	}
+
+	var errs field.ErrorList
+	if strings.HasSuffix(obj.GroupVersionKind().Group, ".m.upbound.io") {
+		errs = append(errs, validateProviderConfigKind(p)...)
+	}
+	if r.validate != nil {
+		errs = append(errs, r.validate(p)...)
+	}
+	if op == nil {
+		return errs
+	}
+	for _, f := range r.immutable {
+		errs = append(errs, validateImmutable(p, op, f)...)
+	}
+	return errs
+}
+
+const forProvider = "spec.forProvider"
+
+// A kindRules validates the managed resources of a kind.
+type kindRules struct {
+	// immutable are the fields below spec.forProvider that cannot be
+	// changed once set. They mirror the +immutable markers of the API types.
+	immutable []string
+
+	// validate returns the errors of the parameters of a managed resource,
+	// if any.
+	validate func(p *fieldpath.Paved) field.ErrorList
+}
+
+var rules = map[string]kindRules{
+	"Configuration": {
+		immutable: []string{"name", "organizationName", "templateId", "provider", "context", "repo", "private"},
+	},
+	"ControlPlane": {
+		immutable: []string{"name", "organizationName", "description", "configurationId"},
+		validate:  validateName(validateDNSLabel),
+	},
+	"ControlPlanePermission": {
+		immutable: []string{"organizationName", "teamId", "controlPlane"},
+	},
+	"Organization": {
+		immutable: []string{"name", "displayName"},
+		validate:  validateName(validateDNSLabel),
+	},
+	"OrganizationInvite": {
+		immutable: []string{"organizationName", "email", "role", "teamIds"},
+	},
+	"OrganizationMember": {
+		immutable: []string{"organizationName", "username"},
+	},
+	"Permission": {
+		validate: validatePermission,
+	},
+	"Repository": {
+		immutable: []string{"name"},
+		validate:  validateName(validateRepositoryName),
+	},
+	"RepositoryAccessPolicy": {
+		immutable: []string{"organizationName", "repository"},
+	},
+	"Robot": {
+		validate: validateName(validateNotEmpty),
+	},
+	"RobotAccount": {
+		immutable: []string{"name", "description", "organizationName"},
+		validate:  validateName(validateNotEmpty),
+	},
+	"Team": {
+		validate: validateTeam,
+	},
+	"Token": {
+		immutable: []string{"owner.type", "owner.id"},
+		validate:  validateToken,
+	},
+	"UserTeamMembership": {
+		immutable: []string{"username", "email"},
+	},
+}
+
+// validateImmutable returns an error if the supplied field below
+// spec.forProvider was set before and has been changed. Setting an unset
+// field is allowed so that it can be late-initialized or resolved from a
+// reference.
+func validateImmutable(p, old *fieldpath.Paved, f string) field.ErrorList {
+	path := forProvider + "." + f
+	ov, err := old.GetValue(path)
+	if err != nil {
+		return nil
+	}
+	v, _ := p.GetValue(path)
+	if reflect.DeepEqual(ov, v) {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fieldPath(path), v, "field is immutable")}
+}
+
+// validateProviderConfigKind returns an error if a namespaced managed resource
+// references a kind of provider config the provider cannot read.
+func validateProviderConfigKind(p *fieldpath.Paved) field.ErrorList {
+	kind, err := p.GetString("spec.providerConfigRef.kind")
+	if err != nil {
+		return nil
+	}
+	switch kind {
+	case pcv1alpha1.ProviderConfigKind, pcv1alpha1.ClusterProviderConfigKind:
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(fieldPath("spec.providerConfigRef.kind"), kind,
+		[]string{pcv1alpha1.ProviderConfigKind, pcv1alpha1.ClusterProviderConfigKind})}
+}
+
+// validateName returns a validation of spec.forProvider.name that uses the
+// supplied name rule.
+func validateName(rule func(name string) []string) func(p *fieldpath.Paved) field.ErrorList {
+	return func(p *fieldpath.Paved) field.ErrorList {
+		name, err := p.GetString(forProvider + ".name")
+		if err != nil {
+			return nil
+		}
+		var errs field.ErrorList
+		for _, msg := range rule(name) {
+			errs = append(errs, field.Invalid(fieldPath(forProvider+".name"), name, msg))
+		}
+		return errs
+	}
+}
+
+func validateNotEmpty(name string) []string {
+	if name == "" {
+		return []string{"must not be empty"}
+	}
+	return nil
+}
+
+// Organizations and control planes are addressed by name in hostnames and
+// URLs, so their names have to be DNS labels.
+func validateDNSLabel(name string) []string {
+	return validation.IsDNS1123Label(name)
+}
+
+// repositoryName matches the path components of OCI repositories, which
+// Upbound repositories are.
+var repositoryName = regexp.MustCompile(`^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*$`)
+
+func validateRepositoryName(name string) []string {
+	if !repositoryName.MatchString(name) {
+		return []string{"must consist of lower case alphanumeric characters separated by '.', '_', '__' or '-', e.g. 'my-configuration', regex used for validation is '" + repositoryName.String() + "'"}
+	}
+	return nil
+}
+
+func validateTeam(p *fieldpath.Paved) field.ErrorList {
+	errs := validateName(validateNotEmpty)(p)
+	if id, err := p.GetInteger(forProvider + ".organizationId"); err == nil && id < 0 {
+		errs = append(errs, field.Invalid(fieldPath(forProvider+".organizationId"), id, "must not be negative"))
+	}
+	return errs
+}
+
+func validateToken(p *fieldpath.Paved) field.ErrorList {
+	errs := validateName(validateNotEmpty)(p)
+	typ, _ := p.GetString(forProvider + ".owner.type")
+	switch typ {
+	case iamv1beta1.OwnerTypeUsers, iamv1beta1.OwnerTypeControlPlanes, iamv1beta1.OwnerTypeRobots:
+	default:
+		return append(errs, field.NotSupported(fieldPath(forProvider+".owner.type"), typ,
+			[]string{iamv1beta1.OwnerTypeUsers, iamv1beta1.OwnerTypeControlPlanes, iamv1beta1.OwnerTypeRobots}))
+	}
+	if id, err := p.GetString(forProvider + ".owner.id"); err == nil {
+		if err := iamv1beta1.ValidateOwnerID(typ, &id); err != nil {
+			errs = append(errs, field.Invalid(fieldPath(forProvider+".owner.id"), id, err.Error()))
+		}
+	}
+	return errs
+}
+
+// permissions are the permissions Upbound grants teams on repositories.
+var permissions = []string{"admin", "read", "write", "view"}
+
+func validatePermission(p *fieldpath.Paved) field.ErrorList {
+	perm, err := p.GetString(forProvider + ".permission")
+	if err != nil {
+		return nil
+	}
+	for _, valid := range permissions {
+		if perm == valid {
+			return nil
+		}
+	}
+	return field.ErrorList{field.NotSupported(fieldPath(forProvider+".permission"), perm, permissions)}
+}
+
+// fieldPath returns the field.Path of the supplied dot separated path.
+func fieldPath(path string) *field.Path {
+	s := strings.Split(path, ".")
+	return field.NewPath(s[0], s[1:]...)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 Upbound Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func mr(apiVersion, kind string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]any{"name": "example"},
+		"spec":       spec,
+	}}
+}
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		obj  *unstructured.Unstructured
+		old  *unstructured.Unstructured
+		want []string
+	}{
+		"ValidTeam": {
+			obj: mr("iam.upbound.io/v1alpha1", "Team", map[string]any{
+				"forProvider": map[string]any{"name": "platform", "organizationId": int64(42)},
+			}),
+		},
+		"NegativeOrganizationID": {
+			obj: mr("iam.upbound.io/v1alpha1", "Team", map[string]any{
+				"forProvider": map[string]any{"name": "platform", "organizationId": int64(-1)},
+			}),
+			want: []string{"spec.forProvider.organizationId: Invalid value: -1: must not be negative"},
+		},
+		"InvalidOwnerID": {
+			obj: mr("iam.m.upbound.io/v1alpha1", "Token", map[string]any{
+				"forProvider": map[string]any{"name": "ci", "owner": map[string]any{"type": "users", "id": "jane"}},
+			}),
+			want: []string{`spec.forProvider.owner.id: Invalid value: "jane": owner ID "jane" of type users must be an integer`},
+		},
+		"UnknownPermission": {
+			obj: mr("repository.upbound.io/v1alpha1", "Permission", map[string]any{
+				"forProvider": map[string]any{"permission": "owner"},
+			}),
+			want: []string{`spec.forProvider.permission: Unsupported value: "owner": supported values: "admin", "read", "write", "view"`},
+		},
+		"UnknownProviderConfigKind": {
+			obj: mr("iam.m.upbound.io/v1alpha1", "Robot", map[string]any{
+				"forProvider":       map[string]any{"name": "ci"},
+				"providerConfigRef": map[string]any{"name": "default", "kind": "LegacyProviderConfig"},
+			}),
+			want: []string{`spec.providerConfigRef.kind: Unsupported value: "LegacyProviderConfig": supported values: "ProviderConfig", "ClusterProviderConfig"`},
+		},
+		"InvalidRepositoryName": {
+			obj: mr("repository.m.upbound.io/v1alpha1", "Repository", map[string]any{
+				"forProvider": map[string]any{"name": "My-Configuration"},
+			}),
+			want: []string{`spec.forProvider.name: Invalid value: "My-Configuration": must consist of lower case alphanumeric characters separated by '.', '_', '__' or '-', e.g. 'my-configuration', regex used for validation is '^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*$'`},
+		},
+		"ImmutableFieldChanged": {
+			obj: mr("iam.upbound.io/v1alpha1", "Token", map[string]any{
+				"forProvider": map[string]any{"name": "ci", "owner": map[string]any{"type": "controlPlanes"}},
+			}),
+			old: mr("iam.upbound.io/v1alpha1", "Token", map[string]any{
+				"forProvider": map[string]any{"name": "ci", "owner": map[string]any{"type": "robots"}},
+			}),
+			want: []string{`spec.forProvider.owner.type: Invalid value: "controlPlanes": field is immutable`},
+		},
+		"ImmutableFieldResolved": {
+			obj: mr("iam.upbound.io/v1alpha1", "Token", map[string]any{
+				"forProvider": map[string]any{"name": "ci", "owner": map[string]any{"type": "robots", "id": "5f2c1e0a-8f5b-4c8e-9d0e-1b2a3c4d5e6f"}},
+			}),
+			old: mr("iam.upbound.io/v1alpha1", "Token", map[string]any{
+				"forProvider": map[string]any{"name": "ci", "owner": map[string]any{"type": "robots"}},
+			}),
+		},
+		"UnchangedInvalidSpec": {
+			obj: mr("repository.upbound.io/v1alpha1", "Repository", map[string]any{
+				"forProvider": map[string]any{"name": "Legacy"},
+			}),
+			old: mr("repository.upbound.io/v1alpha1", "Repository", map[string]any{
+				"forProvider": map[string]any{"name": "Legacy"},
+			}),
+		},
+		"UnknownKind": {
+			obj: mr("iam.upbound.io/v1alpha1", "PullSecretDistribution", map[string]any{}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, err := range Validate(tc.obj, tc.old) {
+				got = append(got, err.Error())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Validate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}